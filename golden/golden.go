@@ -0,0 +1,244 @@
+// -----------------------------------------------------------------------------
+// Asserts for a more convenient testing in Go libraries and applications.
+//
+// Verification of output against golden files
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+// -----------------------------------------------------------------------------
+
+// Package golden compares generated output like reports or rendered
+// templates with expected content stored in golden files under testdata.
+// Mismatches are reported with a difference. When running the tests with
+// the environment variable GOLDEN_UPDATE set to true the golden files are
+// written instead. A test flag -update, if registered by the tested
+// package, switches to the update mode too.
+//
+//	func TestReport(t *testing.T) {
+//	    report := render(data)
+//	    golden.Text(t, report, "report")
+//	}
+package golden
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Configuration
+// -----------------------------------------------------------------------------
+
+const (
+	// Dir is the directory containing the golden files.
+	Dir = "testdata"
+
+	// Extension is the file extension of the golden files.
+	Extension = ".golden"
+
+	// UpdateEnv is the name of the environment variable to update the
+	// golden files. It's the main switch of the update mode.
+	UpdateEnv = "GOLDEN_UPDATE"
+
+	// UpdateFlag is the name of the test flag to update the golden files.
+	// The package doesn't register it to not collide with the flags of
+	// the tested package, it's only used if registered there. It's the
+	// same flag switching verify.Snapshot into the update mode.
+	UpdateFlag = verify.UpdateFlag
+)
+
+// helper is implemented by a T able to mark the calling functions
+// as helpers, like testing.TB or verify.Recorder.
+type helper interface {
+	Helper()
+}
+
+// init registers the package, so its functions are handled as
+// verifications.
+func init() {
+	verify.RegisterPackage()
+}
+
+// Updating returns true if the golden files shall be written instead of
+// being compared. It checks the environment variable and a registered
+// update flag.
+func Updating() bool {
+	if f := flag.Lookup(UpdateFlag); f != nil {
+		if update, err := strconv.ParseBool(f.Value.String()); err == nil && update {
+			return true
+		}
+	}
+	update, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return update
+}
+
+// Path returns the path of the golden file with the given name. The
+// name may contain slashes for subdirectories.
+func Path(name string) string {
+	return filepath.Join(Dir, filepath.FromSlash(name)+Extension)
+}
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// Text checks if the gotten text equals the content of the named golden
+// file. In update mode the file is written instead and the verification
+// succeeds.
+func Text(t verify.T, gotten string, name string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	expected, ok := golden(t, []byte(gotten), name)
+	if !ok {
+		return false
+	}
+	return verify.Equal(t, gotten, string(expected))
+}
+
+// Bytes checks if the gotten bytes equal the content of the named golden
+// file. Mismatches are shown as difference of hex dumps. In update mode
+// the file is written instead and the verification succeeds.
+func Bytes(t verify.T, gotten []byte, name string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	expected, ok := golden(t, gotten, name)
+	if !ok {
+		return false
+	}
+	if bytes.Equal(gotten, expected) {
+		return true
+	}
+	return verify.Equal(t, hex.Dump(gotten), hex.Dump(expected))
+}
+
+// JSON checks if the gotten JSON equals the content of the named golden
+// file. The gotten value can be a JSON document as []byte, string, or
+// json.RawMessage, all other values are encoded as JSON. The documents
+// are normalized with sorted keys and indentation, so the golden files
+// are readable and differences are shown line by line. In update mode
+// the normalized document is written instead and the verification
+// succeeds.
+func JSON(t verify.T, gotten any, name string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	normalized, err := normalizeJSON(gotten)
+	if !verify.NoError(t, err) {
+		return false
+	}
+	expected, ok := golden(t, normalized, name)
+	if !ok {
+		return false
+	}
+	expected, err = normalizeJSON(expected)
+	if !verify.NoError(t, err) {
+		return false
+	}
+	return verify.Equal(t, string(normalized), string(expected))
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// golden reads the named golden file. In update mode the content is
+// written instead and returned as expected one, so the comparison of
+// the caller succeeds.
+func golden(t verify.T, content []byte, name string) ([]byte, bool) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	path := Path(name)
+	if Updating() {
+		err := os.MkdirAll(filepath.Dir(path), 0o755)
+		if err == nil {
+			err = os.WriteFile(path, content, 0o644)
+		}
+		if !verify.NoError(t, err) {
+			return nil, false
+		}
+		if ht, ok := t.(testing.TB); ok {
+			ht.Logf("updated golden file %s", path)
+		}
+		return content, true
+	}
+	expected, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		err = fmt.Errorf("golden file %s does not exist, run the tests with %s=true to create it", path, UpdateEnv)
+	}
+	if !verify.NoError(t, err) {
+		return nil, false
+	}
+	return expected, true
+}
+
+// normalizeJSON returns the indented JSON with sorted keys and canonical
+// integral numbers.
+func normalizeJSON(v any) ([]byte, error) {
+	var doc []byte
+	switch tv := v.(type) {
+	case []byte:
+		doc = tv
+	case json.RawMessage:
+		doc = tv
+	case string:
+		doc = []byte(tv)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode JSON: %v", err)
+		}
+		doc = encoded
+	}
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	var decoded any
+	if err := dec.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	decoded = canonicalNumbers(decoded)
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(decoded); err != nil {
+		return nil, fmt.Errorf("cannot encode JSON: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// canonicalNumbers writes integral JSON numbers like 2.0 or 1e3 as integers,
+// so they don't differ from their golden counterparts.
+func canonicalNumbers(v any) any {
+	switch tv := v.(type) {
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(string(tv)); ok && r.IsInt() {
+			return json.Number(r.Num().String())
+		}
+	case []any:
+		for i, value := range tv {
+			tv[i] = canonicalNumbers(value)
+		}
+	case map[string]any:
+		for k, value := range tv {
+			tv[k] = canonicalNumbers(value)
+		}
+	}
+	return v
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,122 @@
+// -----------------------------------------------------------------------------
+// Asserts for a more convenient testing in Go libraries and applications.
+//
+// Unit tests
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+// -----------------------------------------------------------------------------
+
+package golden_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+
+	"tideland.dev/go/asserts/golden"
+)
+
+// update is registered like tested packages do, golden must not collide.
+var update = flag.Bool(golden.UpdateFlag, false, "update the golden files")
+
+// TestPath tests the path of the golden files.
+func TestPath(t *testing.T) {
+	verify.Equal(t, golden.Path("report"), filepath.Join("testdata", "report.golden"))
+	verify.Equal(t, golden.Path("reports/daily"), filepath.Join("testdata", "reports", "daily.golden"))
+}
+
+// TestUpdate tests the writing of the golden files in update mode.
+func TestUpdate(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(golden.UpdateEnv, "true")
+
+	verify.True(t, golden.Updating())
+
+	rec := verify.NewRecorder()
+	verify.True(t, golden.Text(rec, "Hello, World!\n", "text"))
+	verify.True(t, golden.Bytes(rec, []byte{0x00, 0xff}, "nested/bytes"))
+	verify.True(t, golden.JSON(rec, map[string]any{"b": 2, "a": "<1>"}, "json"))
+	verify.Length(t, rec.Errors(), 0)
+
+	content, err := os.ReadFile(golden.Path("text"))
+	verify.NoError(t, err)
+	verify.Equal(t, string(content), "Hello, World!\n")
+	content, err = os.ReadFile(golden.Path("nested/bytes"))
+	verify.NoError(t, err)
+	verify.DeepEqual(t, content, []byte{0x00, 0xff})
+	content, err = os.ReadFile(golden.Path("json"))
+	verify.NoError(t, err)
+	verify.Equal(t, string(content), "{\n  \"a\": \"<1>\",\n  \"b\": 2\n}\n")
+}
+
+// TestUpdateFlag tests the switch to the update mode by a registered flag.
+func TestUpdateFlag(t *testing.T) {
+	t.Setenv(golden.UpdateEnv, "")
+	t.Cleanup(func() { *update = false })
+
+	verify.False(t, golden.Updating())
+	verify.NoError(t, flag.Set(golden.UpdateFlag, "true"))
+	verify.True(t, golden.Updating())
+}
+
+// TestCompare tests the comparison with existing golden files.
+func TestCompare(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(golden.UpdateEnv, "")
+
+	verify.NoError(t, os.MkdirAll("testdata", 0o755))
+	verify.NoError(t, os.WriteFile(golden.Path("text"), []byte("alpha\nbeta\n"), 0o644))
+	verify.NoError(t, os.WriteFile(golden.Path("bytes"), []byte{1, 2, 3}, 0o644))
+	verify.NoError(t, os.WriteFile(golden.Path("json"), []byte(`{"name": "x", "values": [1, 2]}`), 0o644))
+
+	verify.False(t, golden.Updating())
+
+	// Positive test cases with regular testing.T
+	golden.Text(t, "alpha\nbeta\n", "text")
+	golden.Bytes(t, []byte{1, 2, 3}, "bytes")
+	golden.JSON(t, `{"values":[1,2.0],"name":"x"}`, "json")
+	golden.JSON(t, struct {
+		Name   string `json:"name"`
+		Values []int  `json:"values"`
+	}{"x", []int{1, 2}}, "json")
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	golden.Text(ct, "alpha\ngamma\n", "text")
+	golden.Bytes(ct, []byte{1, 2, 4}, "bytes")
+	golden.JSON(ct, []byte(`{"name": "y", "values": [1, 2]}`), "json")
+	golden.JSON(ct, `{"name":`, "json")
+	golden.Text(ct, "alpha\n", "missing")
+
+	verify.FailedVerifications(ct, "is equal", "is equal", "is equal", "is no error", "is no error")
+}
+
+// TestMessages tests the failure messages of the golden files.
+func TestMessages(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(golden.UpdateEnv, "")
+
+	verify.NoError(t, os.MkdirAll("testdata", 0o755))
+	verify.NoError(t, os.WriteFile(golden.Path("text"), []byte("alpha"), 0o644))
+
+	rec := verify.NewRecorder()
+	text := "beta"
+
+	golden.Text(rec, text, "text")
+	golden.Text(rec, text, "missing")
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "is equal" verification of 'text': got 'beta', expected 'alpha'`,
+		"fail \"is no error\" verification of 'text': got 'golden file " + golden.Path("missing") +
+			" does not exist, run the tests with GOLDEN_UPDATE=true to create it', expected '<nil>'",
+	})
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,239 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Time based verifications of asynchronous behavior
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Options
+// -----------------------------------------------------------------------------
+
+// defaultPollInterval is the time between two evaluations of a
+// condition if not configured otherwise.
+const defaultPollInterval = 10 * time.Millisecond
+
+// PollOption configures the polling of the asynchronous verifications.
+type PollOption func(p *poller)
+
+// PollInterval sets the time between two evaluations of a condition.
+func PollInterval(interval time.Duration) PollOption {
+	return func(p *poller) {
+		if interval > 0 {
+			p.interval = interval
+		}
+	}
+}
+
+// PollContext sets a context whose cancellation stops the polling.
+func PollContext(ctx context.Context) PollOption {
+	return func(p *poller) {
+		if ctx != nil {
+			p.ctx = ctx
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// Eventually checks if the condition succeeds within the given timeout.
+// The condition is evaluated repeatedly and succeeds when none of the
+// verifications it runs on the passed T fails. When giving up, the
+// failures of the last completed evaluation are reported, or a first
+// one still blocking as in progress.
+//
+//	verify.Eventually(t, func(ct verify.T) {
+//	    verify.Equal(ct, counter.Load(), 5)
+//	}, time.Second)
+func Eventually(t T, condition func(ct T), timeout time.Duration, opts ...PollOption) bool {
+	p := newPoller(timeout, opts)
+	msgs, stopped, err := p.poll(condition, func(failed bool) bool { return !failed })
+	if !stopped {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "eventually", fmt.Sprintf("success within %v", timeout), fmsgs(msgs, err))
+		return false
+	}
+	return true
+}
+
+// Consistently checks if the condition succeeds during the whole given
+// duration. The first failing evaluation is reported.
+func Consistently(t T, condition func(ct T), duration time.Duration, opts ...PollOption) bool {
+	p := newPoller(duration, opts)
+	msgs, stopped, err := p.poll(condition, func(failed bool) bool { return failed })
+	if stopped || err != nil {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "consistently", fmt.Sprintf("success for %v", duration), fmsgs(msgs, err))
+		return false
+	}
+	return true
+}
+
+// Never checks if the condition never succeeds during the given duration.
+// It's the opposite of Eventually.
+func Never(t T, condition func(ct T), duration time.Duration, opts ...PollOption) bool {
+	p := newPoller(duration, opts)
+	_, stopped, err := p.poll(condition, func(failed bool) bool { return !failed })
+	if stopped || err != nil {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		got := "success"
+		if err != nil {
+			got = err.Error()
+		}
+		verificationFailure(t, "never", fmt.Sprintf("no success for %v", duration), got)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Poller
+// -----------------------------------------------------------------------------
+
+// poller repeatedly evaluates conditions.
+type poller struct {
+	ctx      context.Context
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// newPoller creates a poller with the given timeout and options.
+func newPoller(timeout time.Duration, opts []PollOption) *poller {
+	p := &poller{
+		ctx:      context.Background(),
+		timeout:  timeout,
+		interval: defaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// inProgress describes an evaluation still running when giving up.
+const inProgress = "evaluation still in progress"
+
+// poll evaluates the condition until stop returns true for its outcome,
+// the timeout elapses, or the context is cancelled. Each evaluation runs
+// in an own goroutine, so a fatal verification only ends this one, while
+// a panic is raised again in the polling goroutine. A blocking evaluation
+// doesn't keep the polling from giving up. It returns the failures of the
+// last completed evaluation, if it has been stopped, and the error of a
+// cancelled context or of giving up before any evaluation has been
+// completed. Both report the evaluation still in progress. A timeout of
+// zero or less evaluates the condition exactly once.
+func (p *poller) poll(condition func(ct T), stop func(failed bool) bool) ([]string, bool, error) {
+	ctx, cancel := context.WithTimeout(p.ctx, p.timeout)
+	if p.timeout <= 0 {
+		// No time for polling, but one evaluation is awaited.
+		ctx, cancel = context.WithCancel(p.ctx)
+	}
+	defer cancel()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	var last *Recorder
+	for {
+		r := NewRecorder()
+		done := evaluate(r, condition)
+		var e evaluation
+		select {
+		case e = <-done:
+		case <-ctx.Done():
+			// Prefer an evaluation completed at the same time.
+			select {
+			case e = <-done:
+			default:
+				return p.givenUp(r, last)
+			}
+		}
+		if e.panicked {
+			panic(e.value)
+		}
+		last = r
+		if stop(r.Failed()) {
+			return r.Errors(), true, nil
+		}
+		if p.timeout <= 0 {
+			return r.Errors(), false, nil
+		}
+		select {
+		case <-ctx.Done():
+			return r.Errors(), false, p.ctx.Err()
+		case <-ticker.C:
+			if ctx.Err() != nil {
+				// Don't start an evaluation after giving up.
+				return r.Errors(), false, p.ctx.Err()
+			}
+		}
+	}
+}
+
+// givenUp returns the outcome of giving up on the evaluation still in
+// progress with the recorder. Without a cancelled context the failures
+// of the last completed evaluation are returned, if there's one.
+func (p *poller) givenUp(r, last *Recorder) ([]string, bool, error) {
+	switch {
+	case p.ctx.Err() != nil:
+		return r.Errors(), false, fmt.Errorf("%w, %s", p.ctx.Err(), inProgress)
+	case last == nil:
+		return r.Errors(), false, errors.New(inProgress)
+	}
+	return last.Errors(), false, nil
+}
+
+// evaluation is the outcome of one evaluation of a condition.
+type evaluation struct {
+	panicked bool
+	value    any
+}
+
+// evaluate runs the condition with the recorder in the background. The
+// returned channel receives the outcome when it's done. It's buffered, so
+// an abandoned evaluation can still finish.
+func evaluate(r *Recorder, condition func(ct T)) <-chan evaluation {
+	done := make(chan evaluation, 1)
+	go func() {
+		var e evaluation
+		defer func() {
+			if v := recover(); v != nil {
+				e.panicked, e.value = true, v
+			}
+			done <- e
+		}()
+		r.Run(condition)
+	}()
+	return done
+}
+
+// fmsgs formats the collected failure messages of an evaluation
+// and a possible context error.
+func fmsgs(msgs []string, err error) string {
+	if err != nil {
+		msgs = append([]string{err.Error()}, msgs...)
+	}
+	if len(msgs) == 0 {
+		return "no failure"
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,228 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for asynchronous verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestEventually tests the Eventually verification function.
+func TestEventually(t *testing.T) {
+	var counter atomic.Int64
+
+	go func() {
+		for i := 0; i < 5; i++ {
+			time.Sleep(5 * time.Millisecond)
+			counter.Add(1)
+		}
+	}()
+
+	// Positive test case with regular testing.T
+	verify.Eventually(t, func(ct verify.T) {
+		verify.Equal(ct, counter.Load(), 5)
+	}, time.Second, verify.PollInterval(time.Millisecond))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Eventually(ct, func(ct verify.T) {
+		verify.Equal(ct, counter.Load(), 6)
+	}, 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	verify.Eventually(ct, func(ct verify.T) {
+		verify.True(ct, false)
+	}, time.Second, verify.PollContext(ctx))
+
+	verify.FailureCount(ct, 2)
+}
+
+// TestEventuallyLastFailure tests the reporting of the last observed failure.
+func TestEventuallyLastFailure(t *testing.T) {
+	rec := verify.NewRecorder()
+	var counter atomic.Int64
+
+	verify.Eventually(rec, func(ct verify.T) {
+		verify.Less(ct, counter.Add(1), 0)
+	}, 30*time.Millisecond, verify.PollInterval(10*time.Millisecond))
+
+	verify.Length(t, rec.Errors(), 1)
+	verify.Match(t, rec.Errors()[0], `^fail "eventually" verification: got 'fail "is less" verification of 'counter\.Add\(1\)': got '\d+', expected '0'', expected 'success within 30ms'$`)
+	verify.More(t, counter.Load(), 1)
+}
+
+// TestConsistently tests the Consistently verification function.
+func TestConsistently(t *testing.T) {
+	var counter atomic.Int64
+
+	// Positive test case with regular testing.T
+	verify.Consistently(t, func(ct verify.T) {
+		verify.Less(ct, counter.Add(1), 100)
+	}, 30*time.Millisecond)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Consistently(ct, func(ct verify.T) {
+		verify.Less(ct, counter.Add(1), 5)
+	}, time.Second, verify.PollInterval(time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	verify.Consistently(ct, func(ct verify.T) {
+		verify.True(ct, true)
+	}, time.Second, verify.PollContext(ctx))
+
+	verify.FailureCount(ct, 2)
+}
+
+// TestNever tests the Never verification function.
+func TestNever(t *testing.T) {
+	var counter atomic.Int64
+
+	// Positive test case with regular testing.T
+	verify.Never(t, func(ct verify.T) {
+		verify.More(ct, counter.Add(1), 1000)
+	}, 30*time.Millisecond)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test case with continuation testing
+	verify.Never(ct, func(ct verify.T) {
+		verify.More(ct, counter.Add(1), 5)
+	}, time.Second, verify.PollInterval(time.Millisecond))
+
+	verify.FailureCount(ct, 1)
+}
+
+// TestPollingRequire tests fatal verifications inside of conditions.
+func TestPollingRequire(t *testing.T) {
+	var counter atomic.Int64
+	reached := 0
+
+	// Positive test cases with regular testing.T
+	verify.Eventually(t, func(ct verify.T) {
+		verify.More(verify.Require(ct), counter.Add(1), 3)
+		reached++
+	}, time.Second, verify.PollInterval(time.Millisecond))
+	verify.Equal(t, reached, 1)
+	verify.Never(t, func(ct verify.T) {
+		verify.True(verify.Require(ct), false)
+	}, 20*time.Millisecond, verify.PollInterval(time.Millisecond))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test case with continuation testing
+	verify.Consistently(ct, func(ct verify.T) {
+		verify.Less(verify.Require(ct), counter.Add(1), 10)
+	}, time.Second, verify.PollInterval(time.Millisecond))
+
+	verify.FailedVerifications(ct, "consistently")
+}
+
+// TestPollingZeroTimeout tests the single evaluation of conditions
+// without time for polling.
+func TestPollingZeroTimeout(t *testing.T) {
+	var counter atomic.Int64
+
+	// Positive test cases with regular testing.T
+	verify.Eventually(t, func(ct verify.T) {
+		counter.Add(1)
+	}, 0)
+	verify.Consistently(t, func(ct verify.T) {
+		counter.Add(1)
+	}, 0)
+	verify.Never(t, func(ct verify.T) {
+		verify.True(ct, false)
+		counter.Add(1)
+	}, -time.Second)
+	verify.Equal(t, counter.Load(), 3)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Eventually(ct, func(ct verify.T) {
+		verify.True(ct, false)
+	}, 0)
+	verify.Consistently(ct, func(ct verify.T) {
+		verify.True(ct, false)
+	}, 0)
+	verify.Never(ct, func(ct verify.T) {}, 0)
+
+	verify.FailedVerifications(ct, "eventually", "consistently", "never")
+}
+
+// TestPollingBlocked tests giving up on conditions blocking
+// their evaluation.
+func TestPollingBlocked(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Eventually(ct, func(ct verify.T) {
+		<-block
+	}, 20*time.Millisecond)
+	verify.Consistently(ct, func(ct verify.T) {
+		<-block
+	}, 20*time.Millisecond)
+	verify.Never(ct, func(ct verify.T) {
+		<-block
+	}, time.Second, verify.PollContext(ctx))
+
+	verify.FailedVerifications(ct, "eventually", "consistently", "never")
+
+	// Reporting of the evaluation still in progress.
+	rec := verify.NewRecorder()
+	verify.Eventually(rec, func(ct verify.T) {
+		verify.True(ct, false)
+		<-block
+	}, 20*time.Millisecond)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "eventually" verification: got 'evaluation still in progress; ` +
+			`fail "is true" verification: got 'false', expected 'true'', expected 'success within 20ms'`,
+	})
+}
+
+// TestPollingPanic tests that a panicking condition surfaces in the
+// testing goroutine instead of crashing the test binary.
+func TestPollingPanic(t *testing.T) {
+	var counts map[string]int
+
+	verify.PanicsMatching(t, func() {
+		verify.Eventually(t, func(ct verify.T) {
+			counts["x"] = 1
+		}, time.Second, verify.PollInterval(time.Millisecond))
+	}, "assignment to entry in nil map")
+	verify.PanicsWithValue(t, func() {
+		verify.Consistently(t, func(ct verify.T) {
+			panic("boom")
+		}, time.Second, verify.PollInterval(time.Millisecond))
+	}, "boom")
+}
+
+// EOF
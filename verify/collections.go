@@ -0,0 +1,281 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of collections
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// ElementsMatch checks if the gotten and expected slices contain the same
+// elements regardless of their order. The number of occurrences of each
+// element has to be the same too.
+func ElementsMatch[S ~[]E, E comparable](t T, gotten, expected S, infos ...string) bool {
+	missing, unexpected := subtract(expected, gotten), subtract(gotten, expected)
+	if len(missing) > 0 || len(unexpected) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "elements match", expected, gotten, infos, "missing", missing, "unexpected", unexpected)
+		return false
+	}
+	return true
+}
+
+// ElementsMatchWith checks if the gotten and expected slices contain the
+// same elements regardless of their order like ElementsMatch, but the
+// elements are compared deeply and configured by the options. So they
+// don't need to be comparable.
+func ElementsMatchWith[S ~[]E, E any](t T, gotten, expected S, opts []Option, infos ...string) bool {
+	missing, unexpected := unmatchedWith(gotten, expected, opts)
+	if len(missing) > 0 || len(unexpected) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "elements match", expected, gotten, infos, "missing", missing, "unexpected", unexpected)
+		return false
+	}
+	return true
+}
+
+// Subset checks if all elements of the gotten slice are contained in
+// the expected slice.
+func Subset[S ~[]E, E comparable](t T, gotten, expected S, infos ...string) bool {
+	unexpected := exclusive(gotten, expected)
+	if len(unexpected) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "is subset", expected, gotten, infos, "unexpected", unexpected)
+		return false
+	}
+	return true
+}
+
+// Superset checks if the gotten slice contains all elements of the
+// expected slice. It's the opposite of Subset.
+func Superset[S ~[]E, E comparable](t T, gotten, expected S, infos ...string) bool {
+	missing := exclusive(expected, gotten)
+	if len(missing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "is superset", expected, gotten, infos, "missing", missing)
+		return false
+	}
+	return true
+}
+
+// Disjoint checks if the gotten and expected slices have no
+// element in common.
+func Disjoint[S ~[]E, E comparable](t T, gotten, expected S, infos ...string) bool {
+	shared := intersection(gotten, expected)
+	if len(shared) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "is disjoint", expected, gotten, infos, "common", shared)
+		return false
+	}
+	return true
+}
+
+// Unique checks if the gotten slice contains no duplicate elements.
+func Unique[S ~[]E, E comparable](t T, gotten S, infos ...string) bool {
+	dups := duplicates(gotten)
+	if len(dups) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "is unique", "no duplicates", gotten, infos, "duplicates", dups)
+		return false
+	}
+	return true
+}
+
+// ContainsAll checks if the gotten slice contains all elements of the
+// expected slice. In opposite to Superset each occurrence of an element
+// in expected needs an own occurrence in gotten.
+func ContainsAll[S ~[]E, E comparable](t T, gotten, expected S, infos ...string) bool {
+	missing := subtract(expected, gotten)
+	if len(missing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		collectionFailure(t, "contains all", expected, gotten, infos, "missing", missing)
+		return false
+	}
+	return true
+}
+
+// ContainsAny checks if the gotten slice contains at least one
+// element of the expected slice.
+func ContainsAny[S ~[]E, E comparable](t T, gotten, expected S, infos ...string) bool {
+	if len(intersection(gotten, expected)) == 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "contains any", expected, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// collectionFailure raises an error listing the named groups of
+// elements explaining the failure, e.g. the missing ones.
+func collectionFailure(t T, verification string, expected, got any, infos []string, groups ...any) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	var details []string
+	for i := 0; i+1 < len(groups); i += 2 {
+		elements := reflect.ValueOf(groups[i+1])
+		if elements.Len() == 0 {
+			continue
+		}
+		format := "%s: %v"
+		if elements.Type().Elem().Kind() == reflect.String {
+			// Quoted, so empty strings and spaces stay visible.
+			format = "%s: %q"
+		}
+		details = append(details, fmt.Sprintf(format, groups[i], groups[i+1]))
+	}
+	reportFailure(t, failure{
+		verification: verification,
+		expected:     expected,
+		got:          got,
+		details:      strings.Join(details, "\n"),
+		infos:        infos,
+	})
+}
+
+// counts returns how often each element is contained.
+func counts[E comparable](elements []E) map[E]int {
+	cs := make(map[E]int, len(elements))
+	for _, e := range elements {
+		cs[e]++
+	}
+	return cs
+}
+
+// subtract returns the elements of a not matched by an own occurrence
+// in b, in the order of a.
+func subtract[E comparable](a, b []E) []E {
+	cs := counts(b)
+	var rest []E
+	for _, e := range a {
+		if cs[e] > 0 {
+			cs[e]--
+			continue
+		}
+		rest = append(rest, e)
+	}
+	return rest
+}
+
+// unmatchedWith pairs the gotten and expected elements, compared deeply
+// with the options, as often as possible. As options like tolerances are
+// not transitive it searches a maximum matching via augmenting paths
+// instead of taking the first equal element. It returns the expected
+// and the gotten elements left without a partner, in their order.
+func unmatchedWith[E any](gotten, expected []E, opts []Option) (missing, unexpected []E) {
+	equal := make([][]bool, len(expected))
+	for i, e := range expected {
+		equal[i] = make([]bool, len(gotten))
+		for j, g := range gotten {
+			equal[i][j] = newComparer(opts...).equal(g, e)
+		}
+	}
+	// partners contains the index of the expected element paired
+	// with each gotten element, -1 if there's none.
+	partners := make([]int, len(gotten))
+	for j := range partners {
+		partners[j] = -1
+	}
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j := range gotten {
+			if !equal[i][j] || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if partners[j] < 0 || augment(partners[j], visited) {
+				partners[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i, e := range expected {
+		if !augment(i, make([]bool, len(gotten))) {
+			missing = append(missing, e)
+		}
+	}
+	for j, g := range gotten {
+		if partners[j] < 0 {
+			unexpected = append(unexpected, g)
+		}
+	}
+	return missing, unexpected
+}
+
+// exclusive returns the distinct elements of a not contained
+// in b, in the order of a.
+func exclusive[E comparable](a, b []E) []E {
+	cs := counts(b)
+	seen := make(map[E]bool)
+	var rest []E
+	for _, e := range a {
+		if cs[e] == 0 && !seen[e] {
+			seen[e] = true
+			rest = append(rest, e)
+		}
+	}
+	return rest
+}
+
+// intersection returns the distinct elements of a contained
+// in b, in the order of a.
+func intersection[E comparable](a, b []E) []E {
+	cs := counts(b)
+	seen := make(map[E]bool)
+	var common []E
+	for _, e := range a {
+		if cs[e] > 0 && !seen[e] {
+			seen[e] = true
+			common = append(common, e)
+		}
+	}
+	return common
+}
+
+// duplicates returns the distinct elements occurring more than
+// once, in the order of their second occurrence.
+func duplicates[E comparable](elements []E) []E {
+	seen := make(map[E]int)
+	var dups []E
+	for _, e := range elements {
+		seen[e]++
+		if seen[e] == 2 {
+			dups = append(dups, e)
+		}
+	}
+	return dups
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,76 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for collection verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestCollections tests the collection verification functions.
+func TestCollections(t *testing.T) {
+	// Positive test cases with regular testing.T
+	verify.ElementsMatch(t, []int{3, 1, 2, 1}, []int{1, 1, 2, 3})
+	verify.ElementsMatch(t, []string{}, nil)
+	verify.Subset(t, []int{1, 2, 2}, []int{3, 2, 1})
+	verify.Superset(t, []int{3, 2, 1}, []int{1, 1, 2})
+	verify.Disjoint(t, []int{1, 2}, []int{3, 4})
+	verify.Unique(t, []string{"a", "b", "c"})
+	verify.ContainsAll(t, []int{1, 2, 2, 3}, []int{2, 2})
+	verify.ContainsAny(t, []int{1, 2, 3}, []int{5, 3})
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.ElementsMatch(ct, []int{1, 2, 3}, []int{1, 2, 4})
+	verify.ElementsMatch(ct, []int{1, 1, 2}, []int{1, 2, 2})
+	verify.Subset(ct, []int{1, 5}, []int{1, 2})
+	verify.Superset(ct, []int{1, 2}, []int{1, 5})
+	verify.Disjoint(ct, []int{1, 2, 3}, []int{3, 2})
+	verify.Unique(ct, []string{"a", "b", "a"})
+	verify.ContainsAll(ct, []int{1, 2, 3}, []int{2, 2})
+	verify.ContainsAny(ct, []int{1, 2, 3}, []int{4, 5})
+
+	verify.FailedVerifications(ct,
+		"elements match", "elements match", "is subset", "is superset",
+		"is disjoint", "is unique", "contains all", "contains any")
+}
+
+// TestCollectionsDetails tests the listing of the elements explaining
+// the failures.
+func TestCollectionsDetails(t *testing.T) {
+	rec := verify.NewRecorder()
+	ids := []int{1, 2, 2, 5}
+
+	verify.ElementsMatch(rec, ids, []int{1, 2, 3, 4})
+	verify.Superset(rec, ids, []int{1, 3, 4, 3})
+	verify.Unique(rec, ids)
+	verify.Disjoint(rec, ids, []int{5, 2, 7})
+	names := []string{"a"}
+	verify.ElementsMatch(rec, names, []string{"a", ""})
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"elements match\" verification of 'ids': got '[1 2 2 5]', expected '[1 2 3 4]'\n" +
+			"missing: [3 4]\nunexpected: [2 5]",
+		"fail \"is superset\" verification of 'ids': got '[1 2 2 5]', expected '[1 3 4 3]'\n" +
+			"missing: [3 4]",
+		"fail \"is unique\" verification of 'ids': got '[1 2 2 5]', expected 'no duplicates'\n" +
+			"duplicates: [2]",
+		"fail \"is disjoint\" verification of 'ids': got '[1 2 2 5]', expected '[5 2 7]'\n" +
+			"common: [2 5]",
+		"fail \"elements match\" verification of 'names': got '[a]', expected '[a ]'\n" +
+			"missing: [\"\"]",
+	})
+}
+
+// EOF
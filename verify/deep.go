@@ -0,0 +1,383 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Deep structural equality of values
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// DeepEqual checks if the gotten and expected values are deeply equal. In
+// opposite to Equal it works for all types, including slices, maps and
+// structs containing them. Pointers and interfaces are followed, cycles
+// are detected, and unexported fields are compared too. In case of a
+// failure the path of the first difference is reported, for large values
+// all differing paths.
+func DeepEqual(t T, gotten, expected any, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return DeepEqualWith(t, gotten, expected, nil, infos...)
+}
+
+// DeepEqualWith checks if the gotten and expected values are deeply equal
+// like DeepEqual, but the comparison is configured by the options. So
+// e.g. FloatTolerance and TimeTolerance allow to compare calculated
+// results containing floats and times without verifying each field.
+//
+//	verify.DeepEqualWith(t, got, want, []verify.Option{
+//	    verify.FloatTolerance(1e-9),
+//	    verify.IgnoreFields("ID"),
+//	}, "calculated order")
+func DeepEqualWith(t T, gotten, expected any, opts []Option, infos ...string) bool {
+	c := newComparer(opts...)
+	if !c.equal(gotten, expected) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		d := c.diffs[0]
+		reportFailure(t, failure{
+			verification: "is deeply equal",
+			path:         d.path,
+			expected:     d.expected,
+			got:          d.gotten,
+			diff:         renderDiff(expected, gotten, opts...),
+			infos:        infos,
+		})
+		return false
+	}
+	return true
+}
+
+// NotDeepEqual checks if the gotten and expected values are not deeply
+// equal. It's the opposite of DeepEqual.
+func NotDeepEqual(t T, gotten, expected any, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return NotDeepEqualWith(t, gotten, expected, nil, infos...)
+}
+
+// NotDeepEqualWith checks if the gotten and expected values are not deeply
+// equal according to the options. It's the opposite of DeepEqualWith.
+func NotDeepEqualWith(t T, gotten, expected any, opts []Option, infos ...string) bool {
+	c := newComparer(opts...)
+	if c.equal(gotten, expected) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is not deeply equal", expected, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Comparer
+// -----------------------------------------------------------------------------
+
+// difference describes one difference found by the comparer.
+type difference struct {
+	path     string
+	gotten   string
+	expected string
+}
+
+// visit identifies a pair of compared references to detect cycles.
+type visit struct {
+	gotten   uintptr
+	expected uintptr
+	typ      reflect.Type
+}
+
+// comparer walks two values recursively and collects their differences.
+type comparer struct {
+	all              bool
+	floatTolerance   float64
+	timeTolerance    time.Duration
+	ignoredFields    []string
+	ignoreUnexported bool
+	equateEmpty      bool
+	sorters          map[reflect.Type]func(s reflect.Value) reflect.Value
+	comparers        map[reflect.Type]func(g, e any) bool
+	visited          map[visit]bool
+	diffs            []difference
+}
+
+// newComparer creates a comparer stopping at the first difference.
+func newComparer(opts ...Option) *comparer {
+	c := &comparer{
+		visited: make(map[visit]bool),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// equal compares both values and returns true if no difference
+// has been found.
+func (c *comparer) equal(gotten, expected any) bool {
+	// Addressable values allow the options to access unexported fields.
+	c.compare("", addressable(reflect.ValueOf(gotten)), addressable(reflect.ValueOf(expected)))
+	return len(c.diffs) == 0
+}
+
+// done returns true if the comparison can stop.
+func (c *comparer) done() bool {
+	return !c.all && len(c.diffs) > 0
+}
+
+// differ adds a difference at the given path.
+func (c *comparer) differ(path string, gotten, expected string) {
+	c.diffs = append(c.diffs, difference{
+		path:     path,
+		gotten:   gotten,
+		expected: expected,
+	})
+}
+
+// seen checks if a pair of references has already been visited. It
+// marks the pair as visited too.
+func (c *comparer) seen(g, e reflect.Value) bool {
+	v := visit{g.Pointer(), e.Pointer(), g.Type()}
+	if c.visited[v] {
+		return true
+	}
+	c.visited[v] = true
+	return false
+}
+
+// compare walks the gotten and expected values.
+func (c *comparer) compare(path string, g, e reflect.Value) {
+	if c.done() {
+		return
+	}
+	if !g.IsValid() || !e.IsValid() {
+		if g.IsValid() != e.IsValid() {
+			c.differ(path, fval(g), fval(e))
+		}
+		return
+	}
+	if g.Type() != e.Type() {
+		c.differ(path, ftyp(g), ftyp(e))
+		return
+	}
+	if c.compareWith(path, g, e) {
+		return
+	}
+	if g.Type() == timeType && c.timeTolerance > 0 {
+		if gt, et, ok := timesOf(g, e); ok {
+			if !c.timesEqual(gt, et) {
+				c.differ(path, gt.Format(time.RFC3339Nano), et.Format(time.RFC3339Nano))
+			}
+			return
+		}
+	}
+	switch g.Kind() {
+	case reflect.Array:
+		for i := 0; i < g.Len(); i++ {
+			c.compare(fmt.Sprintf("%s[%d]", path, i), g.Index(i), e.Index(i))
+		}
+	case reflect.Slice:
+		if g.IsNil() != e.IsNil() && !c.bothEmpty(g, e) {
+			c.differ(path, fval(g), fval(e))
+			return
+		}
+		if g.Len() == e.Len() && g.Pointer() == e.Pointer() {
+			return
+		}
+		if c.seen(g, e) {
+			return
+		}
+		gs, es := c.sorted(g, e)
+		c.compareElements(path, gs, es)
+	case reflect.Interface:
+		if g.IsNil() || e.IsNil() {
+			if g.IsNil() != e.IsNil() {
+				c.differ(path, fval(g), fval(e))
+			}
+			return
+		}
+		// Copies of the contained values stay accessible for the options.
+		c.compare(path, addressable(g.Elem()), addressable(e.Elem()))
+	case reflect.Pointer:
+		if g.Pointer() == e.Pointer() {
+			return
+		}
+		if g.IsNil() || e.IsNil() {
+			c.differ(path, fval(g), fval(e))
+			return
+		}
+		if c.seen(g, e) {
+			return
+		}
+		c.compare(path, g.Elem(), e.Elem())
+	case reflect.Struct:
+		for i := 0; i < g.NumField(); i++ {
+			field := g.Type().Field(i)
+			fpath := path + "." + field.Name
+			if c.ignored(field, fpath) {
+				continue
+			}
+			c.compare(fpath, g.Field(i), e.Field(i))
+		}
+	case reflect.Map:
+		if g.IsNil() != e.IsNil() && !c.bothEmpty(g, e) {
+			c.differ(path, fval(g), fval(e))
+			return
+		}
+		if g.Pointer() == e.Pointer() {
+			return
+		}
+		if c.seen(g, e) {
+			return
+		}
+		c.compareEntries(path, g, e)
+	case reflect.Func:
+		if !g.IsNil() || !e.IsNil() {
+			c.differ(path, "func", "func")
+		}
+	case reflect.Chan, reflect.UnsafePointer:
+		if g.Pointer() != e.Pointer() {
+			c.differ(path, fval(g), fval(e))
+		}
+	case reflect.Float32, reflect.Float64:
+		if !c.floatsEqual(g, e) && !scalarEqual(g, e) {
+			c.differ(path, fval(g), fval(e))
+		}
+	default:
+		if !scalarEqual(g, e) {
+			c.differ(path, fval(g), fval(e))
+		}
+	}
+}
+
+// compareElements compares the elements of two slices.
+func (c *comparer) compareElements(path string, g, e reflect.Value) {
+	n := min(g.Len(), e.Len())
+	for i := 0; i < n; i++ {
+		c.compare(fmt.Sprintf("%s[%d]", path, i), g.Index(i), e.Index(i))
+	}
+	for i := n; i < g.Len() && !c.done(); i++ {
+		c.differ(fmt.Sprintf("%s[%d]", path, i), fval(g.Index(i)), "<missing>")
+	}
+	for i := n; i < e.Len() && !c.done(); i++ {
+		c.differ(fmt.Sprintf("%s[%d]", path, i), "<missing>", fval(e.Index(i)))
+	}
+}
+
+// compareEntries compares the entries of two maps in the
+// order of their sorted keys.
+func (c *comparer) compareEntries(path string, g, e reflect.Value) {
+	for _, k := range sortedKeys(e) {
+		kpath := fmt.Sprintf("%s[%#v]", path, k)
+		gv := g.MapIndex(k)
+		if !gv.IsValid() {
+			if c.done() {
+				return
+			}
+			c.differ(kpath, "<missing>", fval(e.MapIndex(k)))
+			continue
+		}
+		// Map values aren't addressable, so compare accessible copies.
+		c.compare(kpath, addressable(gv), addressable(e.MapIndex(k)))
+	}
+	for _, k := range sortedKeys(g) {
+		if !e.MapIndex(k).IsValid() && !c.done() {
+			c.differ(fmt.Sprintf("%s[%#v]", path, k), fval(g.MapIndex(k)), "<missing>")
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// scalarEqual compares values of the basic kinds. Working on the kinds
+// instead of the interfaces allows to compare unexported fields too.
+func scalarEqual(g, e reflect.Value) bool {
+	switch g.Kind() {
+	case reflect.Bool:
+		return g.Bool() == e.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return g.Int() == e.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return g.Uint() == e.Uint()
+	case reflect.Float32, reflect.Float64:
+		return g.Float() == e.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return g.Complex() == e.Complex()
+	case reflect.String:
+		return g.String() == e.String()
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a map sorted to get a deterministic order.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessValue(keys[i], keys[j])
+	})
+	return keys
+}
+
+// lessValue orders two values. Values of different kinds, like the keys
+// of a map[any]V, are ordered by their kind. Numbers and strings are
+// compared by their values, all others by their formatted representation.
+func lessValue(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+	if a.Kind() != b.Kind() {
+		return a.Kind() < b.Kind()
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+	return fmt.Sprintf("%#v", a) < fmt.Sprintf("%#v", b)
+}
+
+// fval formats a reflected value for the output.
+func fval(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		if v.IsNil() {
+			return "<nil>"
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// ftyp formats a reflected value together with its type.
+func ftyp(v reflect.Value) string {
+	return fmt.Sprintf("%v (%v)", v, v.Type())
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
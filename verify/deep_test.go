@@ -0,0 +1,139 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for deep equality
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+type address struct {
+	Street string
+	City   string
+}
+
+type person struct {
+	Name    string
+	Tags    []string
+	Address *address
+	Props   map[string]any
+	secret  int
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+// TestDeepEqual tests the DeepEqual and NotDeepEqual verification functions.
+func TestDeepEqual(t *testing.T) {
+	alice := func() person {
+		return person{
+			Name:    "Alice",
+			Tags:    []string{"a", "b"},
+			Address: &address{"Main Street", "Oldenburg"},
+			Props:   map[string]any{"age": 42, "langs": []string{"go"}},
+			secret:  1,
+		}
+	}
+	ring := func(values ...int) *node {
+		first := &node{Value: values[0]}
+		current := first
+		for _, v := range values[1:] {
+			current.Next = &node{Value: v}
+			current = current.Next
+		}
+		current.Next = first
+		return first
+	}
+
+	// Positive test cases with regular testing.T
+	verify.DeepEqual(t, []int{1, 2, 3}, []int{1, 2, 3})
+	verify.DeepEqual(t, map[string][]int{"a": {1}}, map[string][]int{"a": {1}})
+	verify.DeepEqual(t, alice(), alice())
+	verify.DeepEqual(t, ring(1, 2, 3), ring(1, 2, 3))
+	verify.DeepEqual(t, nil, nil)
+	verify.NotDeepEqual(t, []int{1, 2}, []int{1, 2, 3})
+	verify.NotDeepEqual(t, ring(1, 2), ring(1, 3))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	other := alice()
+	other.secret = 2
+
+	// Negative test cases with continuation testing
+	verify.DeepEqual(ct, []int{1, 2, 3}, []int{1, 2, 4})
+	verify.DeepEqual(ct, []int{1, 2}, []int{1, 2, 3})
+	verify.DeepEqual(ct, []int(nil), []int{})
+	verify.DeepEqual(ct, map[string]int{"a": 1}, map[string]int{"b": 1})
+	verify.DeepEqual(ct, other, alice())
+	verify.DeepEqual(ct, 1, int64(1))
+	verify.DeepEqual(ct, nil, []int{})
+	verify.NotDeepEqual(ct, alice(), alice())
+
+	verify.FailureCount(ct, 8)
+}
+
+// TestDeepEqualPath tests the reporting of the first differing path.
+func TestDeepEqualPath(t *testing.T) {
+	rec := verify.NewRecorder()
+
+	got := person{
+		Name:    "Alice",
+		Address: &address{"Main Street", "Oldenburg"},
+		Props:   map[string]any{"langs": []string{"go", "rust"}},
+	}
+	expected := person{
+		Name:    "Alice",
+		Address: &address{"Main Street", "Bremen"},
+		Props:   map[string]any{"langs": []string{"go", "zig"}},
+	}
+
+	verify.DeepEqual(rec, got, expected)
+	verify.Length(t, rec.Errors(), 1)
+	verify.Substring(t, "at '.Address.City': got 'Oldenburg', expected 'Bremen'", rec.Errors()[0])
+
+	got.Address.City = "Bremen"
+	verify.DeepEqual(rec, got, expected)
+	verify.Length(t, rec.Errors(), 2)
+	verify.Substring(t, `at '.Props["langs"][1]': got 'rust', expected 'zig'`, rec.Errors()[1])
+}
+
+// TestDeepEqualUnexported tests values reached through unexported
+// map and interface fields.
+func TestDeepEqualUnexported(t *testing.T) {
+	type holder struct {
+		m map[string]int
+		v any
+	}
+	base := errors.New("base")
+
+	// Positive test cases with regular testing.T
+	verify.DeepEqual(t, holder{map[string]int{"a": 1}, nil}, holder{map[string]int{"a": 1}, nil})
+	verify.DeepEqual(t, holder{v: address{"Main Street", "Oldenburg"}}, holder{v: address{"Main Street", "Oldenburg"}})
+	verify.DeepEqual(t, fmt.Errorf("x: %w", base), fmt.Errorf("x: %w", base))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.DeepEqual(ct, holder{m: map[string]int{"a": 1}}, holder{m: map[string]int{"a": 2}})
+	verify.DeepEqual(ct, holder{v: address{"Main Street", "Oldenburg"}}, holder{v: address{"Main Street", "Bremen"}})
+	verify.DeepEqual(ct, fmt.Errorf("x: %w", base), fmt.Errorf("x: %w", errors.New("other")))
+
+	verify.FailedVerifications(ct, "is deeply equal", "is deeply equal", "is deeply equal")
+}
+
+// EOF
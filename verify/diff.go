@@ -0,0 +1,212 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Human readable differences of failed comparisons
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Constants
+// -----------------------------------------------------------------------------
+
+const (
+	// diffThreshold is the formatted length of values above which
+	// a difference is rendered instead of a single line message.
+	diffThreshold = 80
+
+	// diffContext is the number of unchanged lines around changes
+	// in a line based difference.
+	diffContext = 3
+
+	// diffMaxCells limits the size of the table used to find the
+	// longest common subsequence of lines.
+	diffMaxCells = 4 << 20
+)
+
+// -----------------------------------------------------------------------------
+// Difference rendering
+// -----------------------------------------------------------------------------
+
+// renderDiff returns a readable difference of the expected and gotten
+// values if they are too large or complex for a single line message.
+// Strings are compared line by line, structured values by the paths of
+// their differing fields according to the options. Otherwise the result
+// is empty.
+func renderDiff(expected, gotten any, opts ...Option) string {
+	es, eok := expected.(string)
+	gs, gok := gotten.(string)
+	if eok && gok {
+		if !needsDiff(es) && !needsDiff(gs) {
+			return ""
+		}
+		return lineDiff(es, gs)
+	}
+	if !isStructured(expected) || !isStructured(gotten) {
+		return ""
+	}
+	if !needsDiff(fmt.Sprintf("%v", expected)) && !needsDiff(fmt.Sprintf("%v", gotten)) {
+		return ""
+	}
+	return pathDiff(expected, gotten, opts...)
+}
+
+// needsDiff checks if a formatted value is too long or contains
+// multiple lines.
+func needsDiff(s string) bool {
+	return len(s) > diffThreshold || strings.Contains(s, "\n")
+}
+
+// isStructured checks if a value is composed of other values.
+func isStructured(v any) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Array, reflect.Map, reflect.Pointer, reflect.Slice, reflect.Struct:
+		return true
+	}
+	return false
+}
+
+// pathDiff lists all differences of two structured values with
+// their paths.
+func pathDiff(expected, gotten any, opts ...Option) string {
+	c := newComparer(opts...)
+	c.all = true
+	if c.equal(gotten, expected) {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("--- expected\n+++ got")
+	for _, d := range c.diffs {
+		path := d.path
+		if path == "" {
+			path = "."
+		}
+		fmt.Fprintf(&sb, "\n%s: got '%s', expected '%s'", path, d.gotten, d.expected)
+	}
+	return sb.String()
+}
+
+// -----------------------------------------------------------------------------
+// Line difference
+// -----------------------------------------------------------------------------
+
+// edit is one line of a line based difference.
+type edit struct {
+	op   byte
+	line string
+}
+
+// lineDiff returns a unified difference of the expected and
+// gotten text.
+func lineDiff(expected, gotten string) string {
+	edits := lineEdits(strings.Split(expected, "\n"), strings.Split(gotten, "\n"))
+	var sb strings.Builder
+	sb.WriteString("--- expected\n+++ got")
+	for _, h := range hunks(edits) {
+		el, gl := 1, 1
+		for _, e := range edits[:h[0]] {
+			if e.op != '+' {
+				el++
+			}
+			if e.op != '-' {
+				gl++
+			}
+		}
+		ec, gc := 0, 0
+		for _, e := range edits[h[0]:h[1]] {
+			if e.op != '+' {
+				ec++
+			}
+			if e.op != '-' {
+				gc++
+			}
+		}
+		fmt.Fprintf(&sb, "\n@@ -%d,%d +%d,%d @@", el, ec, gl, gc)
+		for _, e := range edits[h[0]:h[1]] {
+			fmt.Fprintf(&sb, "\n%c%s", e.op, e.line)
+		}
+	}
+	return sb.String()
+}
+
+// lineEdits computes the edits to get from the expected to the
+// gotten lines based on their longest common subsequence.
+func lineEdits(expected, gotten []string) []edit {
+	n, m := len(expected), len(gotten)
+	if n*m > diffMaxCells {
+		// Too large, so simply replace everything.
+		edits := make([]edit, 0, n+m)
+		for _, l := range expected {
+			edits = append(edits, edit{'-', l})
+		}
+		for _, l := range gotten {
+			edits = append(edits, edit{'+', l})
+		}
+		return edits
+	}
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if expected[i] == gotten[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	edits := make([]edit, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case expected[i] == gotten[j]:
+			edits = append(edits, edit{' ', expected[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, edit{'-', expected[i]})
+			i++
+		default:
+			edits = append(edits, edit{'+', gotten[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		edits = append(edits, edit{'-', expected[i]})
+	}
+	for ; j < m; j++ {
+		edits = append(edits, edit{'+', gotten[j]})
+	}
+	return edits
+}
+
+// hunks returns the ranges of the edits containing changes together
+// with their surrounding context.
+func hunks(edits []edit) [][2]int {
+	var hs [][2]int
+	for i, e := range edits {
+		if e.op == ' ' {
+			continue
+		}
+		lo := max(0, i-diffContext)
+		hi := min(len(edits), i+diffContext+1)
+		if len(hs) > 0 && lo <= hs[len(hs)-1][1] {
+			hs[len(hs)-1][1] = hi
+			continue
+		}
+		hs = append(hs, [2]int{lo, hi})
+	}
+	return hs
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,102 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for difference rendering
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"strings"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestDiffShort ensures short values keep the single line message.
+func TestDiffShort(t *testing.T) {
+	rec := verify.NewRecorder()
+
+	verify.Equal(rec, "foo", "bar")
+	verify.Length(t, rec.Errors(), 1)
+	verify.Equal(t, rec.Errors()[0], `fail "is equal" verification: got 'foo', expected 'bar'`)
+}
+
+// TestDiffLines tests the unified line difference of multi-line strings.
+func TestDiffLines(t *testing.T) {
+	rec := verify.NewRecorder()
+	expected := strings.Join([]string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten"}, "\n")
+	gotten := strings.Join([]string{"one", "two", "three", "four", "5", "six", "seven", "eight", "nine", "ten", "eleven"}, "\n")
+
+	verify.Equal(rec, gotten, expected, "numbers")
+	verify.Length(t, rec.Errors(), 1)
+
+	lines := strings.Split(rec.Errors()[0], "\n")
+	verify.DeepEqual(t, lines, []string{
+		`fail "is equal" verification of 'gotten': got and expected differ (numbers)`,
+		"--- expected",
+		"+++ got",
+		"@@ -2,9 +2,10 @@",
+		" two",
+		" three",
+		" four",
+		"-five",
+		"+5",
+		" six",
+		" seven",
+		" eight",
+		" nine",
+		" ten",
+		"+eleven",
+	})
+}
+
+// TestDiffHunks tests the splitting of distant changes into hunks.
+func TestDiffHunks(t *testing.T) {
+	rec := verify.NewRecorder()
+	var expected, gotten []string
+	for i := 0; i < 20; i++ {
+		line := strings.Repeat("x", i+1)
+		expected = append(expected, line)
+		gotten = append(gotten, line)
+	}
+	gotten[0] = "first"
+	gotten[19] = "last"
+
+	verify.Equal(rec, strings.Join(gotten, "\n"), strings.Join(expected, "\n"))
+	verify.Length(t, rec.Errors(), 1)
+	verify.Substring(t, "@@ -1,4 +1,4 @@\n-x\n+first\n xx", rec.Errors()[0])
+	verify.Substring(t, "@@ -17,4 +17,4 @@\n", rec.Errors()[0])
+	verify.Substring(t, "\n-xxxxxxxxxxxxxxxxxxxx\n+last", rec.Errors()[0])
+}
+
+// TestDiffPaths tests the path difference of large structured values.
+func TestDiffPaths(t *testing.T) {
+	rec := verify.NewRecorder()
+	expected := person{
+		Name:    "Alice Wonderland",
+		Tags:    []string{"curious", "adventurous", "polite"},
+		Address: &address{"Rabbit Hole 1", "Wonderland"},
+		Props:   map[string]any{"friends": []string{"White Rabbit", "Cheshire Cat"}},
+	}
+	gotten := person{
+		Name:    "Alice Wonderland",
+		Tags:    []string{"curious", "brave", "polite"},
+		Address: &address{"Rabbit Hole 1", "Looking Glass"},
+		Props:   map[string]any{"friends": []string{"White Rabbit", "Cheshire Cat"}},
+	}
+
+	verify.DeepEqual(rec, gotten, expected)
+	verify.Length(t, rec.Errors(), 1)
+	verify.Equal(t, rec.Errors()[0], `fail "is deeply equal" verification of 'gotten': got and expected differ
+--- expected
++++ got
+.Tags[1]: got 'brave', expected 'adventurous'
+.Address.City: got 'Looking Glass', expected 'Wonderland'`)
+}
+
+// EOF
@@ -0,0 +1,192 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of error trees like joined and multi-errors
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// ContainsErrors checks if all expected errors are part of the tree of
+// the gotten error. The tree is followed through single wrapped errors
+// as well as through joined ones like errors.Join() or fmt.Errorf() with
+// multiple %w create them. Each one is checked with errors.Is().
+func ContainsErrors(t T, gotten error, expected ...error) bool {
+	if gotten == nil {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "contains errors", ferrors(expected), nil)
+		return false
+	}
+	var missing []error
+	for _, err := range expected {
+		if !errors.Is(gotten, err) {
+			missing = append(missing, err)
+		}
+	}
+	if len(missing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		reportFailure(t, failure{
+			verification: "contains errors",
+			expected:     ferrors(expected),
+			got:          ferror(gotten),
+			details:      "missing " + ferrors(missing) + "\n" + ferrtree(gotten),
+		})
+		return false
+	}
+	return true
+}
+
+// ErrorCount checks if the tree of the gotten error contains the
+// expected number of leaf errors, so the ones not wrapping others.
+// A nil error has none.
+func ErrorCount(t T, gotten error, expected int, infos ...string) bool {
+	count := 0
+	walkErrors(gotten, func(err error, depth int) {
+		if len(unwrapAll(err)) == 0 {
+			count++
+		}
+	})
+	if count != expected {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		details := ""
+		if gotten != nil {
+			details = ferrtree(gotten)
+		}
+		reportFailure(t, failure{
+			verification: "has error count",
+			expected:     expected,
+			got:          count,
+			details:      details,
+			infos:        infos,
+		})
+		return false
+	}
+	return true
+}
+
+// ErrorAs checks if the tree of the gotten error contains an error of
+// type E and returns it for further verifications. It uses the
+// errors.As() function.
+//
+//	if pathErr, ok := verify.ErrorAs[*fs.PathError](t, err); ok {
+//	    verify.Equal(t, pathErr.Op, "open")
+//	}
+func ErrorAs[E error](t T, gotten error, infos ...string) (E, bool) {
+	var target E
+	if !errors.As(gotten, &target) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		reportFailure(t, failure{
+			verification: "error as type",
+			expected:     reflect.TypeFor[E]().String(),
+			got:          ferror(gotten),
+			details:      ferrtreeIfNested(gotten),
+			infos:        infos,
+		})
+		return target, false
+	}
+	return target, true
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// unwrapAll returns the errors directly wrapped by the error, both for
+// Unwrap() error and Unwrap() []error.
+func unwrapAll(err error) []error {
+	var wrapped []error
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		wrapped = []error{e.Unwrap()}
+	case interface{ Unwrap() []error }:
+		wrapped = e.Unwrap()
+	}
+	children := make([]error, 0, len(wrapped))
+	for _, child := range wrapped {
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// walkErrors calls f for each error of the tree in depth-first order.
+func walkErrors(err error, f func(err error, depth int)) {
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		f(err, depth)
+		for _, child := range unwrapAll(err) {
+			walk(child, depth+1)
+		}
+	}
+	if err != nil {
+		walk(err, 0)
+	}
+}
+
+// ferror formats the message of an error in one line.
+func ferror(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	return strings.ReplaceAll(err.Error(), "\n", " | ")
+}
+
+// ferrors formats a list of errors.
+func ferrors(errs []error) string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = ferror(err)
+	}
+	return "[" + strings.Join(msgs, ", ") + "]"
+}
+
+// ftarget formats the target of errors.As() by the type it points to.
+func ftarget(target any) string {
+	if tt := reflect.TypeOf(target); tt != nil && tt.Kind() == reflect.Pointer {
+		return tt.Elem().String()
+	}
+	return fmt.Sprintf("%v", target)
+}
+
+// ferrtree formats the tree of the error with one indented line per
+// error containing its message and type.
+func ferrtree(err error) string {
+	var sb strings.Builder
+	sb.WriteString("error tree:")
+	walkErrors(err, func(err error, depth int) {
+		fmt.Fprintf(&sb, "\n%s- %s (%T)", strings.Repeat("  ", depth+1), ferror(err), err)
+	})
+	return sb.String()
+}
+
+// ferrtreeIfNested formats the tree of the error only if it wraps other
+// errors, there's nothing to add for single errors.
+func ferrtreeIfNested(err error) string {
+	if len(unwrapAll(err)) == 0 {
+		return ""
+	}
+	return ferrtree(err)
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,117 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for error tree verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestErrorTrees tests the verifications of joined and wrapped errors.
+func TestErrorTrees(t *testing.T) {
+	_, openErr := os.Open("/does/not/exist")
+	joined := errors.Join(io.EOF, fmt.Errorf("closing: %w", io.ErrClosedPipe))
+	multi := fmt.Errorf("saving: %w and %w", openErr, joined)
+
+	// Positive test cases with regular testing.T
+	verify.ContainsErrors(t, joined, io.EOF, io.ErrClosedPipe)
+	verify.ContainsErrors(t, multi, fs.ErrNotExist, io.EOF, io.ErrClosedPipe)
+	verify.ErrorCount(t, nil, 0)
+	verify.ErrorCount(t, io.EOF, 1)
+	verify.ErrorCount(t, joined, 2)
+	verify.ErrorCount(t, multi, 3)
+	verify.UnwrapError(t, joined, io.EOF)
+	verify.UnwrapError(t, multi, openErr)
+	verify.IsError(t, multi, io.ErrClosedPipe)
+
+	pathErr, ok := verify.ErrorAs[*fs.PathError](t, multi)
+	verify.True(t, ok)
+	verify.Equal(t, pathErr.Op, "open")
+	verify.Equal(t, pathErr.Path, "/does/not/exist")
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.ContainsErrors(ct, joined, io.EOF, io.ErrUnexpectedEOF)
+	verify.ContainsErrors(ct, nil, io.EOF)
+	verify.ErrorCount(ct, multi, 2)
+	verify.UnwrapError(ct, multi, io.ErrUnexpectedEOF)
+	verify.UnwrapError(ct, io.EOF, io.EOF)
+
+	pathErr, ok = verify.ErrorAs[*fs.PathError](ct, joined)
+	verify.False(t, ok)
+	verify.True(t, pathErr == nil)
+
+	verify.FailedVerifications(ct,
+		"contains errors", "contains errors", "has error count",
+		"error unwraps to", "error unwraps to", "error as type")
+}
+
+// TestErrorTreeMessages tests the error trees in the failure messages.
+func TestErrorTreeMessages(t *testing.T) {
+	rec := verify.NewRecorder()
+	closing := fmt.Errorf("closing: %w", io.ErrClosedPipe)
+	joined := errors.Join(io.EOF, closing)
+	err := fmt.Errorf("saving: %w", joined)
+
+	verify.ContainsErrors(rec, err, io.EOF, io.ErrUnexpectedEOF)
+	verify.ErrorCount(rec, err, 3)
+	verify.UnwrapError(rec, joined, io.ErrUnexpectedEOF)
+	verify.IsError(rec, err, io.ErrUnexpectedEOF)
+	verify.IsError(rec, io.EOF, io.ErrUnexpectedEOF)
+	verify.ErrorAs[*fs.PathError](rec, err)
+
+	tree := "error tree:\n" +
+		"  - saving: EOF | closing: io: read/write on closed pipe (*fmt.wrapError)\n" +
+		"    - EOF | closing: io: read/write on closed pipe (*errors.joinError)\n" +
+		"      - EOF (*errors.errorString)\n" +
+		"      - closing: io: read/write on closed pipe (*fmt.wrapError)\n" +
+		"        - io: read/write on closed pipe (*errors.errorString)"
+	joinedTree := "error tree:\n" +
+		"  - EOF | closing: io: read/write on closed pipe (*errors.joinError)\n" +
+		"    - EOF (*errors.errorString)\n" +
+		"    - closing: io: read/write on closed pipe (*fmt.wrapError)\n" +
+		"      - io: read/write on closed pipe (*errors.errorString)"
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"contains errors\" verification of 'err': got 'saving: EOF | closing: io: read/write on closed pipe', " +
+			"expected '[EOF, unexpected EOF]'\nmissing [unexpected EOF]\n" + tree,
+		"fail \"has error count\" verification of 'err': got '2', expected '3'\n" + tree,
+		"fail \"error unwraps to\" verification of 'joined': got '[EOF, closing: io: read/write on closed pipe]', " +
+			"expected 'unexpected EOF'\n" + joinedTree,
+		"fail \"is expected error\" verification of 'err': got 'saving: EOF | closing: io: read/write on closed pipe', " +
+			"expected 'unexpected EOF'\n" + tree,
+		"fail \"is expected error\" verification of 'io.EOF': got 'EOF', expected 'unexpected EOF'",
+		"fail \"error as type\" verification of 'err': got 'saving: EOF | closing: io: read/write on closed pipe', " +
+			"expected '*fs.PathError'\n" + tree,
+	})
+
+	// The expected target of AsError is reported by its type.
+	rec.Reset()
+	var pathErr *fs.PathError
+	verify.AsError(rec, err, &pathErr)
+	verify.AsError(rec, nil, &pathErr)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"error as type\" verification of 'err': got 'saving: EOF | closing: io: read/write on closed pipe', " +
+			"expected '*fs.PathError'\n" + tree,
+		"fail \"error as type\" verification: got '<nil>', expected '*fs.PathError'",
+	})
+}
+
+// EOF
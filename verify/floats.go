@@ -0,0 +1,247 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of floating-point numbers
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// AboutRelative checks if the gotten value equals the expected one within
+// a relative epsilon, related to the larger magnitude of both. Infinities
+// are only equal to the same infinity, NaN never is about equal. The
+// failure shows the actual relative error.
+func AboutRelative[F constraints.Float](t T, gotten, expected, epsilon F, infos ...string) bool {
+	g, e := float64(gotten), float64(expected)
+	if g == e {
+		return true
+	}
+	relErr := relativeError(g, e)
+	if !(relErr <= float64(epsilon)) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		expectedDescr := fmt.Sprintf("%v' within '%v relative", expected, epsilon)
+		gottenDescr := fmt.Sprintf("%v' with '%g relative error", gotten, relErr)
+		verificationFailure(t, "is about relative", expectedDescr, gottenDescr, infos...)
+		return false
+	}
+	return true
+}
+
+// AboutULP checks if the gotten value is at most maxULP units in the last
+// place away from the expected one. Zeros of both signs are equal, NaN
+// never is about equal. The failure shows the ULP distance and the actual
+// relative error.
+func AboutULP[F constraints.Float](t T, gotten, expected F, maxULP uint64, infos ...string) bool {
+	distance, ok := ulpDistance(gotten, expected)
+	if !ok || distance > maxULP {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		expectedDescr := fmt.Sprintf("%v' within '%d ULP", expected, maxULP)
+		gottenDescr := fmt.Sprintf("%v' with '%s", gotten, fulp(distance, ok, relativeError(float64(gotten), float64(expected))))
+		verificationFailure(t, "is about ULP", expectedDescr, gottenDescr, infos...)
+		return false
+	}
+	return true
+}
+
+// IsNaN checks if the gotten value is not a number.
+func IsNaN[F constraints.Float](t T, gotten F, infos ...string) bool {
+	if !math.IsNaN(float64(gotten)) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is NaN", math.NaN(), gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// IsInf checks if the gotten value is an infinity according to the sign.
+// Like for math.IsInf a sign > 0 checks for positive, a sign < 0 for
+// negative, and a sign == 0 for either infinity.
+func IsInf[F constraints.Float](t T, gotten F, sign int, infos ...string) bool {
+	if !math.IsInf(float64(gotten), sign) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		expected := "+Inf or -Inf"
+		switch {
+		case sign > 0:
+			expected = "+Inf"
+		case sign < 0:
+			expected = "-Inf"
+		}
+		verificationFailure(t, "is Inf", expected, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// IsFinite checks if the gotten value is neither NaN nor an infinity.
+func IsFinite[F constraints.Float](t T, gotten F, infos ...string) bool {
+	g := float64(gotten)
+	if math.IsNaN(g) || math.IsInf(g, 0) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is finite", "finite number", gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// AboutSlice checks if the gotten and expected slices have the same length
+// and all elements are about equal within the tolerance like for About.
+// All differing elements are reported with their relative errors.
+func AboutSlice[S ~[]F, F constraints.Float](t T, gotten, expected S, tolerance F, infos ...string) bool {
+	if len(gotten) != len(expected) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		floatsFailure(t, "is about slice", expected, gotten, infos, fmt.Sprintf("got length %d, expected %d", len(gotten), len(expected)))
+		return false
+	}
+	if differing := aboutElements("", gotten, expected, tolerance); len(differing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		floatsFailure(t, "is about slice", expected, gotten, infos, differing...)
+		return false
+	}
+	return true
+}
+
+// AboutMatrix checks if the gotten and expected matrices have the same
+// dimensions and all elements are about equal within the tolerance like
+// for About. All differing elements are reported with their relative errors.
+func AboutMatrix[M ~[]S, S ~[]F, F constraints.Float](t T, gotten, expected M, tolerance F, infos ...string) bool {
+	var differing []string
+	if len(gotten) != len(expected) {
+		differing = append(differing, fmt.Sprintf("got %d rows, expected %d", len(gotten), len(expected)))
+	} else {
+		for i := range gotten {
+			row := fmt.Sprintf("[%d]", i)
+			if len(gotten[i]) != len(expected[i]) {
+				differing = append(differing, fmt.Sprintf("%s: got length %d, expected %d", row, len(gotten[i]), len(expected[i])))
+				continue
+			}
+			differing = append(differing, aboutElements(row, gotten[i], expected[i], tolerance)...)
+		}
+	}
+	if len(differing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		floatsFailure(t, "is about matrix", expected, gotten, infos, differing...)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// floatsFailure raises an error listing the differing elements.
+func floatsFailure(t T, verification string, expected, got any, infos []string, differing ...string) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	reportFailure(t, failure{
+		verification: verification,
+		expected:     expected,
+		got:          got,
+		details:      strings.Join(differing, "\n"),
+		infos:        infos,
+	})
+}
+
+// aboutElements returns the descriptions of the elements not being about
+// equal within the tolerance.
+func aboutElements[S ~[]F, F constraints.Float](prefix string, gotten, expected S, tolerance F) []string {
+	var differing []string
+	for i := range gotten {
+		g, e := gotten[i], expected[i]
+		if g == e || (g >= e-tolerance && g <= e+tolerance) {
+			continue
+		}
+		differing = append(differing, fmt.Sprintf("%s[%d]: got '%v', expected '%v' (relative error %g)",
+			prefix, i, g, e, relativeError(float64(g), float64(e))))
+	}
+	return differing
+}
+
+// relativeError returns the difference of both values related to the
+// larger magnitude.
+func relativeError(a, b float64) float64 {
+	if a == b {
+		return 0
+	}
+	return math.Abs(a-b) / math.Max(math.Abs(a), math.Abs(b))
+}
+
+// ulpDistance returns the number of representable floats between both
+// values in their own precision. It's false if one of them is NaN.
+func ulpDistance[F constraints.Float](a, b F) (uint64, bool) {
+	fa, fb := float64(a), float64(b)
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return 0, false
+	}
+	if reflect.TypeOf(a).Kind() == reflect.Float32 {
+		oa, ob := ordered32(float32(a)), ordered32(float32(b))
+		if oa > ob {
+			return uint64(oa - ob), true
+		}
+		return uint64(ob - oa), true
+	}
+	oa, ob := ordered64(fa), ordered64(fb)
+	if oa > ob {
+		return uint64(oa) - uint64(ob), true
+	}
+	return uint64(ob) - uint64(oa), true
+}
+
+// ordered64 maps the bits of a float64 to integers in the order of the floats.
+func ordered64(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		return math.MinInt64 - b
+	}
+	return b
+}
+
+// ordered32 maps the bits of a float32 to integers in the order of the floats.
+func ordered32(f float32) int64 {
+	b := int32(math.Float32bits(f))
+	if b < 0 {
+		return int64(math.MinInt32) - int64(b)
+	}
+	return int64(b)
+}
+
+// fulp formats a ULP distance together with the relative error.
+func fulp(distance uint64, ok bool, relErr float64) string {
+	if !ok {
+		return "NaN distance"
+	}
+	return fmt.Sprintf("%d ULP, %g relative error", distance, relErr)
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,121 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for floating-point verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestFloats tests the floating-point verification functions.
+func TestFloats(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	next := math.Nextafter(1.0, 2.0)
+
+	// Positive test cases with regular testing.T
+	verify.AboutRelative(t, 1e9+1, 1e9, 1e-8)
+	verify.AboutRelative(t, -1e-12, -1.0000001e-12, 1e-6)
+	verify.AboutRelative(t, inf, inf, 1e-9)
+	verify.AboutULP(t, next, 1.0, 1)
+	verify.AboutULP(t, 0.0, math.Copysign(0, -1), 0)
+	verify.AboutULP(t, math.Nextafter32(1, 0), float32(1), 1)
+	verify.AboutULP(t, math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 2)
+	verify.IsNaN(t, nan)
+	verify.IsInf(t, inf, 1)
+	verify.IsInf(t, -inf, 0)
+	verify.IsFinite(t, math.MaxFloat64)
+	verify.AboutSlice(t, []float64{1.0, 2.0}, []float64{1.05, 1.95}, 0.1)
+	verify.AboutMatrix(t, [][]float32{{1, 2}, {3}}, [][]float32{{1, 2.01}, {2.99}}, 0.02)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.About(ct, nan, 1.0, 0.1)
+	verify.About(ct, 1.0, nan, 0.1)
+	verify.AboutRelative(ct, 1.1, 1.0, 0.01)
+	verify.AboutRelative(ct, nan, nan, 1.0)
+	verify.AboutRelative(ct, inf, -inf, 1.0)
+	verify.AboutULP(ct, 1.0, math.Nextafter(next, 2.0), 1)
+	verify.AboutULP(ct, nan, 1.0, 100)
+	verify.IsNaN(ct, 1.0)
+	verify.IsInf(ct, inf, -1)
+	verify.IsFinite(ct, nan)
+	verify.AboutSlice(ct, []float64{1.0}, []float64{1.0, 2.0}, 0.1)
+	verify.AboutSlice(ct, []float64{1.0, nan}, []float64{1.0, 2.0}, 0.1)
+	verify.AboutMatrix(ct, [][]float64{{1.0}}, [][]float64{{1.0}, {2.0}}, 0.1)
+
+	verify.FailedVerifications(ct,
+		"is about equal", "is about equal", "is about relative", "is about relative",
+		"is about relative", "is about ULP", "is about ULP", "is NaN", "is Inf",
+		"is finite", "is about slice", "is about slice", "is about matrix")
+}
+
+// TestFloatsNaNOrdering tests that NaN fails the ordering and range
+// verifications and matchers.
+func TestFloatsNaNOrdering(t *testing.T) {
+	nan := math.NaN()
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Less(ct, nan, 1.0)
+	verify.More(ct, nan, 1.0)
+	verify.Less(ct, 1.0, nan)
+	verify.More(ct, 1.0, nan)
+	verify.InRange(ct, nan, 0.0, 1.0)
+	verify.OutOfRange(ct, nan, 0.0, 1.0)
+	verify.InRange(ct, 0.5, nan, 1.0)
+	verify.That(ct, nan, verify.LessThan(1.0))
+	verify.That(ct, nan, verify.MoreThan(1.0))
+	verify.Sorted(ct, []float64{nan, 1, 2})
+	verify.Sorted(ct, []float64{1, nan, 2})
+	verify.StrictlyIncreasing(ct, []float64{nan, 1, 2})
+	verify.StrictlyDecreasing(ct, []float64{2, 1, nan})
+	verify.SortedSeq(ct, slices.Values([]float64{nan, 1, 2}))
+	verify.StrictlyIncreasingSeq(ct, slices.Values([]float64{1, 2, nan}))
+	verify.StrictlyDecreasingSeq2(ct, slices.All([]float64{nan, 2, 1}))
+
+	verify.FailedVerifications(ct,
+		"is less", "is more", "is less", "is more", "is in range",
+		"is out of range", "is in range", "that", "that", "is sorted",
+		"is sorted", "is strictly increasing", "is strictly decreasing",
+		"is sorted", "is strictly increasing", "is strictly decreasing")
+}
+
+// TestFloatsErrors tests the reporting of the relative errors.
+func TestFloatsErrors(t *testing.T) {
+	rec := verify.NewRecorder()
+	measured := []float64{1.0, 2.5, 3.0, 4.0}
+	grid := [][]float64{{1.0, 2.0}, {3.0, 4.5}, {5.0}}
+
+	verify.AboutRelative(rec, measured[1], 2.0, 0.1)
+	verify.AboutULP(rec, measured[0], 1.0+4*math.Pow(2, -52), 2)
+	verify.AboutSlice(rec, measured, []float64{1.0, 2.0, 3.0, 5.0}, 0.01)
+	verify.AboutMatrix(rec, grid, [][]float64{{1.0, 2.0}, {3.0, 4.0}, {5.0, 6.0}}, 0.01)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "is about relative" verification of 'measured[1]': got '2.5' with '0.2 relative error', expected '2' within '0.1 relative'`,
+		`fail "is about ULP" verification of 'measured[0]': got '1' with '4 ULP, 8.881784197001244e-16 relative error', expected '1.0000000000000009' within '2 ULP'`,
+		"fail \"is about slice\" verification of 'measured': got '[1 2.5 3 4]', expected '[1 2 3 5]'\n" +
+			"[1]: got '2.5', expected '2' (relative error 0.2)\n" +
+			"[3]: got '4', expected '5' (relative error 0.2)",
+		"fail \"is about matrix\" verification of 'grid': got '[[1 2] [3 4.5] [5]]', expected '[[1 2] [3 4] [5 6]]'\n" +
+			"[1][1]: got '4.5', expected '4' (relative error 0.1111111111111111)\n" +
+			"[2]: got length 1, expected 2",
+	})
+}
+
+// EOF
@@ -0,0 +1,302 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of iterator sequences
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"sync/atomic"
+)
+
+// -----------------------------------------------------------------------------
+// Sequence limit
+// -----------------------------------------------------------------------------
+
+// DefaultSeqLimit is the default maximum number of elements consumed
+// from a sequence by the verifications.
+const DefaultSeqLimit = 10000
+
+// maxSeqLen contains the current sequence limit, zero means default.
+var maxSeqLen atomic.Int64
+
+// SetSeqLimit sets the maximum number of elements the verifications
+// consume from an iter.Seq or iter.Seq2. This way infinite sequences
+// cannot block a test. A limit less than one restores the default.
+// The previous limit is returned.
+func SetSeqLimit(limit int) int {
+	if limit < 1 {
+		limit = 0
+	}
+	previous := int(maxSeqLen.Swap(int64(limit)))
+	if previous == 0 {
+		return DefaultSeqLimit
+	}
+	return previous
+}
+
+// seqLimit returns the current sequence limit.
+func seqLimit() int {
+	if limit := maxSeqLen.Load(); limit > 0 {
+		return int(limit)
+	}
+	return DefaultSeqLimit
+}
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// ContainsSeq checks if the sequence contains the expected element. Like
+// Contains the element is the gotten value. Only the elements up to the
+// sequence limit are searched.
+func ContainsSeq[E comparable](t T, gotten E, expected iter.Seq[E], infos ...string) bool {
+	elements, complete := collect(expected)
+	for _, e := range elements {
+		if e == gotten {
+			return true
+		}
+	}
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	if !complete {
+		verificationFailure(t, "contains", fexceeded(), gotten, infos...)
+		return false
+	}
+	verificationFailure(t, "contains", elements, gotten, infos...)
+	return false
+}
+
+// ElementsMatchSeq checks if the gotten and expected sequences contain the
+// same elements regardless of their order. See ElementsMatch. Sequences
+// longer than the sequence limit fail.
+func ElementsMatchSeq[E comparable](t T, gotten, expected iter.Seq[E], infos ...string) bool {
+	gottenElements, gottenComplete := collect(gotten)
+	expectedElements, expectedComplete := collect(expected)
+	if !gottenComplete || !expectedComplete {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "elements match", expectedElements, fexceeded(), infos...)
+		return false
+	}
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return ElementsMatch(t, gottenElements, expectedElements, infos...)
+}
+
+// EverySeq checks if all elements of the gotten sequence satisfy the
+// predicate. The first element violating it is reported with its index.
+// Sequences longer than the sequence limit fail.
+func EverySeq[E any](t T, gotten iter.Seq[E], predicate func(E) bool, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return EverySeq2(t, indexed(gotten), func(_ int, e E) bool { return predicate(e) }, infos...)
+}
+
+// EverySeq2 checks if all key/value pairs of the gotten sequence satisfy
+// the predicate. The first pair violating it is reported. Sequences
+// longer than the sequence limit fail.
+func EverySeq2[K, V any](t T, gotten iter.Seq2[K, V], predicate func(K, V) bool, infos ...string) bool {
+	violation, violated := "", false
+	bounded, exceeded := limited(gotten)
+	for k, v := range bounded {
+		if !predicate(k, v) {
+			violation, violated = fmt.Sprintf("%v at [%v]", v, k), true
+			break
+		}
+	}
+	if !violated && exceeded() {
+		violation, violated = fexceeded(), true
+	}
+	if violated {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "every", "all elements satisfying predicate", violation, infos...)
+		return false
+	}
+	return true
+}
+
+// AnySeq checks if at least one element of the gotten sequence satisfies
+// the predicate. Only the elements up to the sequence limit are checked.
+func AnySeq[E any](t T, gotten iter.Seq[E], predicate func(E) bool, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return AnySeq2(t, indexed(gotten), func(_ int, e E) bool { return predicate(e) }, infos...)
+}
+
+// AnySeq2 checks if at least one key/value pair of the gotten sequence
+// satisfies the predicate.
+func AnySeq2[K, V any](t T, gotten iter.Seq2[K, V], predicate func(K, V) bool, infos ...string) bool {
+	n, satisfied := 0, false
+	bounded, _ := limited(gotten)
+	for k, v := range bounded {
+		if predicate(k, v) {
+			satisfied = true
+			break
+		}
+		n++
+	}
+	if satisfied {
+		return true
+	}
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	verificationFailure(t, "any", "an element satisfying predicate", fmt.Sprintf("none of %d elements", n), infos...)
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// fexceeded describes sequences longer than the limit.
+func fexceeded() string {
+	return fmt.Sprintf("more than %d elements", seqLimit())
+}
+
+// fseq replaces sequences by their type for the output, all other
+// values are returned unchanged.
+func fseq(value any) any {
+	if isSeq(reflect.ValueOf(value)) {
+		return fmt.Sprintf("<%T>", value)
+	}
+	return value
+}
+
+// collect returns the elements of a sequence up to the limit and if
+// the sequence has been consumed completely.
+func collect[E any](seq iter.Seq[E]) ([]E, bool) {
+	if seq == nil {
+		return nil, true
+	}
+	limit := seqLimit()
+	var elements []E
+	for e := range seq {
+		if len(elements) == limit {
+			return elements, false
+		}
+		elements = append(elements, e)
+	}
+	return elements, true
+}
+
+// indexed turns a sequence into one of the indexes and elements.
+func indexed[E any](seq iter.Seq[E]) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		if seq == nil {
+			return
+		}
+		i := 0
+		for e := range seq {
+			if !yield(i, e) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// limited stops a sequence after the limit. The returned function
+// tells if it has been stopped because there were more elements.
+func limited[K, V any](seq iter.Seq2[K, V]) (iter.Seq2[K, V], func() bool) {
+	exceeded := false
+	bounded := func(yield func(K, V) bool) {
+		if seq == nil {
+			return
+		}
+		n, limit := 0, seqLimit()
+		for k, v := range seq {
+			if n == limit {
+				exceeded = true
+				return
+			}
+			if !yield(k, v) {
+				return
+			}
+			n++
+		}
+	}
+	return bounded, func() bool { return exceeded }
+}
+
+// isSeq checks if a value is a function like iter.Seq or iter.Seq2.
+func isSeq(rv reflect.Value) bool {
+	if rv.Kind() != reflect.Func {
+		return false
+	}
+	ft := rv.Type()
+	if ft.NumIn() != 1 || ft.NumOut() != 0 || ft.In(0).Kind() != reflect.Func {
+		return false
+	}
+	yt := ft.In(0)
+	return (yt.NumIn() == 1 || yt.NumIn() == 2) && yt.NumOut() == 1 && yt.Out(0).Kind() == reflect.Bool
+}
+
+// walkSeq calls f with the keys and values of a sequence until f returns
+// false. As f runs inside the sequence it must not report failures.
+// Elements of an iter.Seq are keyed by their index. At most max elements
+// are passed, if there are more the result is true.
+func walkSeq(rv reflect.Value, max int, f func(k, v reflect.Value) bool) bool {
+	if rv.IsNil() {
+		return false
+	}
+	n, exceeded := 0, false
+	visit := func(k, v reflect.Value) bool {
+		if n == max {
+			exceeded = true
+			return false
+		}
+		n++
+		return f(k, v)
+	}
+	if rv.Type().In(0).NumIn() == 1 {
+		for v := range rv.Seq() {
+			if !visit(reflect.ValueOf(n), v) {
+				break
+			}
+		}
+	} else {
+		for k, v := range rv.Seq2() {
+			if !visit(k, v) {
+				break
+			}
+		}
+	}
+	return exceeded
+}
+
+// seqlen returns the number of elements of a sequence or -1 if it
+// exceeds the limit.
+func seqlen(rv reflect.Value) int {
+	n := 0
+	if walkSeq(rv, seqLimit(), func(_, _ reflect.Value) bool { n++; return true }) {
+		return -1
+	}
+	return n
+}
+
+// seqEmpty checks if the value is a sequence and if it is empty. Only
+// the first element is consumed.
+func seqEmpty(in any) (bool, bool) {
+	rv := reflect.ValueOf(in)
+	if !isSeq(rv) {
+		return false, false
+	}
+	return !walkSeq(rv, 0, func(_, _ reflect.Value) bool { return true }), true
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
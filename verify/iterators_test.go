@@ -0,0 +1,149 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for iterator sequence verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestSeqLength tests the length and emptiness of sequences.
+func TestSeqLength(t *testing.T) {
+	var none iter.Seq[int]
+	nums := slices.Values([]int{1, 2, 3})
+	pairs := maps.All(map[string]int{"a": 1, "b": 2})
+
+	// Positive test cases with regular testing.T
+	verify.Length(t, nums, 3)
+	verify.Length(t, pairs, 2)
+	verify.Length(t, none, 0)
+	verify.Empty(t, none)
+	verify.Empty(t, slices.Values([]string{}))
+	verify.NotEmpty(t, nums)
+	verify.NotEmpty(t, naturals())
+	verify.That(t, pairs, verify.WithLength(2))
+	verify.That(t, none, verify.IsEmpty())
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Length(ct, nums, 4)
+	verify.Empty(ct, naturals())
+	verify.NotEmpty(ct, none)
+	verify.That(ct, nums, verify.IsEmpty())
+
+	verify.FailedVerifications(ct, "has length", "empty", "not empty", "that")
+}
+
+// TestSeqLimit tests the limit of consumed elements of infinite sequences.
+func TestSeqLimit(t *testing.T) {
+	previous := verify.SetSeqLimit(100)
+	defer verify.SetSeqLimit(previous)
+
+	verify.Equal(t, previous, verify.DefaultSeqLimit)
+	verify.Equal(t, verify.SetSeqLimit(100), 100)
+
+	rec := verify.NewRecorder()
+	numbers := naturals()
+
+	verify.Length(rec, numbers, 10)
+	verify.ContainsSeq(rec, -1, numbers)
+	verify.AnySeq(rec, numbers, func(n int) bool { return n < 0 })
+	verify.SortedSeq(rec, numbers)
+	verify.EverySeq(rec, numbers, func(n int) bool { return n >= 0 })
+	verify.That(rec, numbers, verify.Each(verify.MoreThan(-1)))
+	verify.That(rec, numbers, verify.Containing(-1))
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "has length" verification of 'numbers': got 'more than 100 elements', expected '10'`,
+		`fail "contains" verification: got '-1', expected 'more than 100 elements'`,
+		`fail "any" verification of 'numbers': got 'none of 100 elements', expected 'an element satisfying predicate'`,
+		`fail "is sorted" verification of 'numbers': got 'more than 100 elements', expected 'ascending order'`,
+		`fail "every" verification of 'numbers': got 'more than 100 elements', expected 'all elements satisfying predicate'`,
+		"fail \"that\" verification of 'numbers': got '<iter.Seq[int]>', expected 'each element is more than '-1''\n" +
+			"got 'more than 100 elements'",
+		"fail \"that\" verification of 'numbers': got '<iter.Seq[int]>', expected 'contains '-1''\n" +
+			"got 'more than 100 elements'",
+	})
+
+	// Sequences up to the limit and slices are checked completely.
+	verify.SortedSeq(t, slices.Values(make([]int, 100)))
+	verify.EverySeq(t, slices.Values(make([]int, 100)), func(n int) bool { return n == 0 })
+	verify.That(t, slices.Values(make([]int, 100)), verify.Each(verify.EqualTo(0)))
+	verify.Sorted(t, make([]int, 1000))
+}
+
+// TestSeqVerifications tests the verifications of sequence elements.
+func TestSeqVerifications(t *testing.T) {
+	nums := slices.Values([]int{1, 2, 3, 2})
+	ages := maps.All(map[string]int{"alice": 42, "bob": 23})
+	positive := func(n int) bool { return n > 0 }
+
+	// Positive test cases with regular testing.T
+	verify.ContainsSeq(t, 3, nums)
+	verify.ContainsSeq(t, 7, naturals())
+	verify.ElementsMatchSeq(t, nums, slices.Values([]int{2, 2, 1, 3}))
+	verify.EverySeq(t, nums, positive)
+	verify.EverySeq2(t, ages, func(name string, age int) bool { return name != "" && age > 18 })
+	verify.AnySeq(t, nums, func(n int) bool { return n == 3 })
+	verify.AnySeq2(t, ages, func(name string, _ int) bool { return name == "bob" })
+	verify.That(t, nums, verify.Each(verify.LessThan(4)))
+	verify.That(t, ages, verify.Each(verify.MoreThan(18)))
+	verify.That(t, nums, verify.Containing(2))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.ContainsSeq(ct, 5, nums)
+	verify.ElementsMatchSeq(ct, nums, slices.Values([]int{1, 2, 3}))
+	verify.ElementsMatchSeq(ct, naturals(), nums)
+	verify.EverySeq(ct, slices.Values([]int{1, 0, -1}), positive)
+	verify.AnySeq2(ct, ages, func(_ string, age int) bool { return age > 50 })
+	verify.That(ct, nums, verify.Each(verify.LessThan(3)))
+	verify.That(ct, nums, verify.Containing(5))
+
+	verify.FailedVerifications(ct,
+		"contains", "elements match", "elements match", "every", "any", "that", "that")
+
+	// Reporting of the failing elements.
+	rec := verify.NewRecorder()
+	verify.EverySeq(rec, nums, func(n int) bool { return n < 3 })
+	verify.That(rec, nums, verify.Each(verify.LessThan(3)))
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "every" verification of 'nums': got '3 at [2]', expected 'all elements satisfying predicate'`,
+		"fail \"that\" verification of 'nums': got '<iter.Seq[int]>', expected 'each element is less than '3''\n" +
+			"element [2]: got '3'",
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// naturals returns an infinite sequence of the natural numbers.
+func naturals() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n := 0; ; n++ {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// EOF
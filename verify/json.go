@@ -0,0 +1,304 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Semantic verifications of JSON documents
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// JSONEqual checks if the gotten and expected JSON documents are
+// semantically equal. Whitespace and the order of object keys don't
+// matter, numbers are compared by their values. Differences are reported
+// as JSON Pointers together with the differing fragments.
+func JSONEqual[D ~string | ~[]byte](t T, gotten, expected D, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return jsonVerification(t, "is JSON equal", false, gotten, expected, nil, infos)
+}
+
+// JSONEqualIgnoring checks if the gotten and expected JSON documents are
+// semantically equal like JSONEqual, but the values at the ignored JSON
+// Pointers are skipped. A segment "*" matches any key or index, so e.g.
+// "/items/*/id" ignores the IDs of all items.
+func JSONEqualIgnoring[D ~string | ~[]byte](t T, gotten, expected D, ignored []string, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return jsonVerification(t, "is JSON equal", false, gotten, expected, ignored, infos)
+}
+
+// JSONSubset checks if the gotten JSON document contains at least the
+// fields of the expected one with equal values. Additional fields of
+// gotten objects are allowed, arrays need the same length and their
+// elements are checked as subsets too.
+func JSONSubset[D ~string | ~[]byte](t T, gotten, expected D, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return jsonVerification(t, "is JSON subset", true, gotten, expected, nil, infos)
+}
+
+// JSONSubsetIgnoring checks if the gotten JSON document contains at least
+// the fields of the expected one like JSONSubset, but the values at the
+// ignored JSON Pointers are skipped like in JSONEqualIgnoring. So volatile
+// fields of the expected document, e.g. generated IDs, can stay in place.
+func JSONSubsetIgnoring[D ~string | ~[]byte](t T, gotten, expected D, ignored []string, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	return jsonVerification(t, "is JSON subset", true, gotten, expected, ignored, infos)
+}
+
+// -----------------------------------------------------------------------------
+// JSON comparison
+// -----------------------------------------------------------------------------
+
+// jsonMissing marks a value missing in one of the documents.
+const jsonMissing = "<missing>"
+
+// jsonComparer walks two decoded JSON documents and collects their
+// differences.
+type jsonComparer struct {
+	subset  bool
+	ignored [][]string
+	diffs   []difference
+}
+
+// newJSONComparer creates a comparer for JSON documents ignoring the
+// passed JSON Pointers.
+func newJSONComparer(subset bool, ignored []string) *jsonComparer {
+	c := &jsonComparer{subset: subset}
+	for _, pointer := range ignored {
+		c.ignored = append(c.ignored, parsePointer(pointer))
+	}
+	return c
+}
+
+// compare walks the gotten and expected values at the path.
+func (c *jsonComparer) compare(path []string, g, e any) {
+	if c.ignores(path) {
+		return
+	}
+	switch ev := e.(type) {
+	case map[string]any:
+		gv, ok := g.(map[string]any)
+		if !ok {
+			c.differ(path, g, e)
+			return
+		}
+		for _, k := range sortedJSONKeys(ev) {
+			kpath := append(slices.Clip(path), k)
+			if _, ok := gv[k]; !ok {
+				if !c.ignores(kpath) {
+					c.diffs = append(c.diffs, difference{fpointer(kpath), jsonMissing, fjson(ev[k])})
+				}
+				continue
+			}
+			c.compare(kpath, gv[k], ev[k])
+		}
+		if c.subset {
+			return
+		}
+		for _, k := range sortedJSONKeys(gv) {
+			kpath := append(slices.Clip(path), k)
+			if _, ok := ev[k]; !ok && !c.ignores(kpath) {
+				c.diffs = append(c.diffs, difference{fpointer(kpath), fjson(gv[k]), jsonMissing})
+			}
+		}
+	case []any:
+		gv, ok := g.([]any)
+		if !ok || len(gv) != len(ev) {
+			c.differ(path, g, e)
+			return
+		}
+		for i := range ev {
+			c.compare(append(slices.Clip(path), strconv.Itoa(i)), gv[i], ev[i])
+		}
+	case json.Number:
+		gv, ok := g.(json.Number)
+		if !ok || !numbersEqual(gv, ev) {
+			c.differ(path, g, e)
+		}
+	default:
+		if g != e {
+			c.differ(path, g, e)
+		}
+	}
+}
+
+// differ adds a difference of two values at the path.
+func (c *jsonComparer) differ(path []string, g, e any) {
+	c.diffs = append(c.diffs, difference{fpointer(path), fjson(g), fjson(e)})
+}
+
+// ignores checks if the path matches one of the ignored pointers.
+func (c *jsonComparer) ignores(path []string) bool {
+	for _, ignored := range c.ignored {
+		if len(ignored) != len(path) {
+			continue
+		}
+		matches := true
+		for i, segment := range ignored {
+			if segment != "*" && segment != path[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// jsonVerification decodes both documents, compares them, and reports
+// the differences.
+func jsonVerification[D ~string | ~[]byte](t T, verification string, subset bool, gotten, expected D, ignored, infos []string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	gv, err := decodeJSON([]byte(gotten))
+	if err != nil {
+		verificationFailure(t, verification, "valid JSON", fmt.Sprintf("invalid JSON: %v", err), infos...)
+		return false
+	}
+	ev, err := decodeJSON([]byte(expected))
+	if err != nil {
+		verificationFailure(t, verification, fmt.Sprintf("invalid JSON: %v", err), "not comparable", infos...)
+		return false
+	}
+	c := newJSONComparer(subset, ignored)
+	c.compare(nil, gv, ev)
+	if len(c.diffs) == 0 {
+		return true
+	}
+	jsonFailure(t, verification, c.diffs, infos)
+	return false
+}
+
+// jsonFailure reports the first difference, all differences are listed
+// in the details if there are more.
+func jsonFailure(t T, verification string, diffs []difference, infos []string) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	first := diffs[0]
+	details := ""
+	if len(diffs) > 1 {
+		lines := make([]string, len(diffs))
+		for i, d := range diffs {
+			lines[i] = fmt.Sprintf("  %s: got '%s', expected '%s'", fpath(d.path), d.gotten, d.expected)
+		}
+		details = "differences:\n" + strings.Join(lines, "\n")
+	}
+	reportFailure(t, failure{
+		verification: verification,
+		path:         first.path,
+		expected:     first.expected,
+		got:          first.gotten,
+		details:      details,
+		infos:        infos,
+	})
+}
+
+// decodeJSON decodes a complete JSON document keeping the numbers
+// as they are.
+func decodeJSON(doc []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("trailing data after document")
+	}
+	return v, nil
+}
+
+// numbersEqual compares two JSON numbers by their values.
+func numbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	ra, aok := new(big.Rat).SetString(string(a))
+	rb, bok := new(big.Rat).SetString(string(b))
+	return aok && bok && ra.Cmp(rb) == 0
+}
+
+// sortedJSONKeys returns the keys of a JSON object in sorted order.
+func sortedJSONKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// parsePointer splits a JSON Pointer into its unescaped segments.
+func parsePointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	return segments
+}
+
+// fpointer formats the segments of a path as JSON Pointer.
+func fpointer(path []string) string {
+	var sb strings.Builder
+	for _, segment := range path {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
+
+// fpath formats a JSON Pointer for the output, the document itself is
+// shown as slash.
+func fpath(pointer string) string {
+	if pointer == "" {
+		return "/"
+	}
+	return pointer
+}
+
+// fjson formats a decoded JSON value as compact JSON fragment.
+func fjson(v any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
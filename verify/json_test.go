@@ -0,0 +1,88 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for JSON verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestJSON tests the JSON verification functions.
+func TestJSON(t *testing.T) {
+	response := []byte(`{
+		"id": "7f3a",
+		"name": "Alice",
+		"age": 42,
+		"tags": ["admin", "dev"],
+		"address": {"city": "Oldenburg", "zip": "26122"},
+		"items": [{"id": 1, "sku": "a-1"}, {"id": 2, "sku": "b-2"}]
+	}`)
+
+	// Positive test cases with regular testing.T
+	verify.JSONEqual(t, `{"a": 1, "b": [true, null]}`, `{ "b": [true,null], "a": 1.0 }`)
+	verify.JSONEqual(t, `1e3`, `1000`)
+	verify.JSONEqual(t, response, response)
+	verify.JSONSubset(t, response, []byte(`{"name": "Alice", "address": {"city": "Oldenburg"}}`))
+	verify.JSONSubset(t, response, []byte(`{"items": [{"sku": "a-1"}, {"sku": "b-2"}]}`))
+	verify.JSONEqualIgnoring(t, `{"id": 1, "at": "now", "v": "x"}`, `{"id": 2, "v": "x"}`, []string{"/id", "/at"})
+	verify.JSONEqualIgnoring(t, `[{"id": 1, "v": 1}, {"id": 2, "v": 2}]`, `[{"id": 5, "v": 1}, {"id": 6, "v": 2}]`, []string{"/*/id"})
+	verify.JSONEqualIgnoring(t, `{"a/b": 1, "m~n": 2}`, `{"a/b": 3, "m~n": 4}`, []string{"/a~1b", "/m~0n"})
+	verify.JSONSubsetIgnoring(t, response, []byte(`{"id": "0000", "name": "Alice"}`), []string{"/id"})
+	verify.JSONSubsetIgnoring(t, response, []byte(`{"items": [{"id": 9, "sku": "a-1"}, {"id": 9}]}`), []string{"/items/*/id"})
+	verify.JSONSubsetIgnoring(t, response, []byte(`{"created": "now", "age": 42}`), []string{"/created"})
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.JSONEqual(ct, `{"a": 1}`, `{"a": 2}`)
+	verify.JSONEqual(ct, `{"a": 1, "b": 2}`, `{"a": 1}`)
+	verify.JSONEqual(ct, `[1, 2]`, `[1, 2, 3]`)
+	verify.JSONEqual(ct, `{"a": 1`, `{"a": 1}`)
+	verify.JSONEqual(ct, `{"a": 1}`, `{"a": 1} {}`)
+	verify.JSONSubset(ct, response, []byte(`{"name": "Bob"}`))
+	verify.JSONSubset(ct, response, []byte(`{"email": "alice@example.com"}`))
+	verify.JSONEqualIgnoring(ct, `{"id": 1, "v": "x"}`, `{"id": 2, "v": "y"}`, []string{"/id"})
+	verify.JSONSubsetIgnoring(ct, response, []byte(`{"id": "0000", "name": "Bob"}`), []string{"/id"})
+	verify.JSONSubsetIgnoring(ct, response, []byte(`{"items": [{"id": 9, "sku": "a-1"}]}`), []string{"/items/*/id"})
+
+	verify.FailedVerifications(ct,
+		"is JSON equal", "is JSON equal", "is JSON equal", "is JSON equal", "is JSON equal",
+		"is JSON subset", "is JSON subset", "is JSON equal", "is JSON subset", "is JSON subset")
+}
+
+// TestJSONPointers tests the reporting of differences as JSON Pointers.
+func TestJSONPointers(t *testing.T) {
+	rec := verify.NewRecorder()
+	body := `{"user": {"name": "Alice", "roles": ["admin"], "a/b": 1}, "count": 2}`
+
+	verify.JSONEqual(rec, body, `{"user": {"name": "Alice", "roles": ["dev"], "a/b": 1}, "count": 2}`)
+	verify.JSONEqual(rec, body, `{"user": {"name": "Bob", "roles": ["admin"], "a/b": 2, "age": 42}}`)
+	verify.JSONSubset(rec, body, `{"user": {"email": "alice@example.com"}}`)
+	verify.JSONEqual(rec, body, `[]`)
+	verify.JSONEqual(rec, `{"a": `, body)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "is JSON equal" verification of 'body' at '/user/roles/0': got '"admin"', expected '"dev"'`,
+		"fail \"is JSON equal\" verification of 'body' at '/user/a~1b': got '1', expected '2'\n" +
+			"differences:\n" +
+			"  /user/a~1b: got '1', expected '2'\n" +
+			"  /user/age: got '<missing>', expected '42'\n" +
+			"  /user/name: got '\"Alice\"', expected '\"Bob\"'\n" +
+			"  /count: got '2', expected '<missing>'",
+		`fail "is JSON subset" verification of 'body' at '/user/email': got '<missing>', expected '"alice@example.com"'`,
+		`fail "is JSON equal" verification of 'body': got '{"count":2,"user":{"a/b":1,"name":"Alice","roles":["admin"]}}', expected '[]'`,
+		`fail "is JSON equal" verification: got 'invalid JSON: unexpected EOF', expected 'valid JSON'`,
+	})
+}
+
+// EOF
@@ -0,0 +1,324 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of individual values inside of JSON documents
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// JSONPath checks if the value at the path inside of the JSON document is
+// the expected one. The document can be passed as []byte, string, or
+// io.Reader. The path starts with $ for the document followed by names
+// like .items or ['items'] and indexes like [0], negative indexes count
+// from the end. So e.g. "$.items[0].name" selects the name of the first
+// item.
+//
+// If expected is a Matcher the value is passed to it with JSON numbers
+// as int if possible, otherwise as float64. So e.g. WithLength(3) or
+// MoreThan(18) can be used. All other expected values are encoded as
+// JSON and compared semantically like with JSONEqual.
+func JSONPath(t T, doc any, path string, expected any, infos ...string) bool {
+	value, missing, err := selectJSON(doc, path)
+	switch {
+	case err != nil:
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "has JSON path value", fexpected(expected), err.Error(), infos...)
+		return false
+	case missing != "":
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		jsonPathFailure(t, "has JSON path value", path, fexpected(expected), jsonMissing, missing, infos)
+		return false
+	}
+	if m, ok := expected.(Matcher); ok {
+		if ok, why := m.Match(naturalJSON(value)); !ok {
+			if ht, ok := t.(helper); ok {
+				ht.Helper()
+			}
+			jsonPathFailure(t, "has JSON path value", path, m.Describe(), fjson(value), fwhy(naturalJSON(value), why), infos)
+			return false
+		}
+		return true
+	}
+	ev, err := encodeJSON(expected)
+	if err != nil {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "has JSON path value", fmt.Sprintf("%v", expected), err.Error(), infos...)
+		return false
+	}
+	c := newJSONComparer(false, nil)
+	c.compare(nil, value, ev)
+	if len(c.diffs) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		jsonPathFailure(t, "has JSON path value", path, fjson(ev), fjson(value), "", infos)
+		return false
+	}
+	return true
+}
+
+// JSONPathExists checks if the path exists inside of the JSON document.
+// See JSONPath for the documents and paths.
+func JSONPathExists(t T, doc any, path string, infos ...string) bool {
+	_, missing, err := selectJSON(doc, path)
+	switch {
+	case err != nil:
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "has JSON path", "existing path", err.Error(), infos...)
+		return false
+	case missing != "":
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		jsonPathFailure(t, "has JSON path", path, "existing path", jsonMissing, missing, infos)
+		return false
+	}
+	return true
+}
+
+// JSONPathNotExists checks if the path does not exist inside of the JSON
+// document. It's the opposite of JSONPathExists.
+func JSONPathNotExists(t T, doc any, path string, infos ...string) bool {
+	value, missing, err := selectJSON(doc, path)
+	switch {
+	case err != nil:
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "not has JSON path", "not existing path", err.Error(), infos...)
+		return false
+	case missing == "":
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		jsonPathFailure(t, "not has JSON path", path, jsonMissing, fjson(value), "", infos)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// JSON path
+// -----------------------------------------------------------------------------
+
+// jsonSegment is one selection of a JSON path.
+type jsonSegment struct {
+	raw     string
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJSONPath splits a JSON path into its segments.
+func parseJSONPath(path string) ([]jsonSegment, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("invalid path '%s': has to start with '$'", path)
+	}
+	var segments []jsonSegment
+	for rest != "" {
+		var segment jsonSegment
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			if end == 1 {
+				return nil, fmt.Errorf("invalid path '%s': empty name", path)
+			}
+			segment = jsonSegment{raw: rest[:end], key: rest[1:end]}
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if len(rest) > 1 && (rest[1] == '\'' || rest[1] == '"') {
+				closing := strings.IndexByte(rest[2:], rest[1]) + 2
+				if closing < 2 || len(rest) <= closing+1 || rest[closing+1] != ']' {
+					return nil, fmt.Errorf("invalid path '%s': unterminated name", path)
+				}
+				segment = jsonSegment{raw: rest[:closing+2], key: rest[2:closing]}
+				break
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path '%s': unterminated index", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid path '%s': invalid index '%s'", path, rest[1:end])
+			}
+			segment = jsonSegment{raw: rest[:end+1], index: index, isIndex: true}
+		default:
+			return nil, fmt.Errorf("invalid path '%s': unexpected '%s'", path, rest)
+		}
+		segments = append(segments, segment)
+		rest = rest[len(segment.raw):]
+	}
+	return segments, nil
+}
+
+// lookupJSON follows the segments through the decoded document. If a
+// segment cannot be selected the reason is returned.
+func lookupJSON(doc any, segments []jsonSegment) (any, string) {
+	current, at := doc, "$"
+	for _, segment := range segments {
+		switch v := current.(type) {
+		case map[string]any:
+			if segment.isIndex {
+				return nil, fmt.Sprintf("cannot select index %d of object at '%s'", segment.index, at)
+			}
+			next, ok := v[segment.key]
+			if !ok {
+				return nil, fmt.Sprintf("key '%s' not found at '%s'", segment.key, at)
+			}
+			current = next
+		case []any:
+			if !segment.isIndex {
+				return nil, fmt.Sprintf("cannot select key '%s' of array at '%s'", segment.key, at)
+			}
+			i := segment.index
+			if i < 0 {
+				i += len(v)
+			}
+			if i < 0 || i >= len(v) {
+				return nil, fmt.Sprintf("index %d out of range at '%s' with length %d", segment.index, at, len(v))
+			}
+			current = v[i]
+		default:
+			return nil, fmt.Sprintf("cannot select '%s' of '%s' at '%s'", segment.raw, fjson(current), at)
+		}
+		at += segment.raw
+	}
+	return current, ""
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// selectJSON reads the document and returns the value at the path. If the
+// path does not exist the reason is returned, invalid documents and paths
+// lead to an error.
+func selectJSON(doc any, path string) (any, string, error) {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := readJSON(doc)
+	if err != nil {
+		return nil, "", err
+	}
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid JSON: %v", err)
+	}
+	value, missing := lookupJSON(v, segments)
+	return value, missing, nil
+}
+
+// readJSON returns the content of the supported document types.
+func readJSON(doc any) ([]byte, error) {
+	switch d := doc.(type) {
+	case []byte:
+		return d, nil
+	case json.RawMessage:
+		return d, nil
+	case string:
+		return []byte(d), nil
+	case io.Reader:
+		data, err := io.ReadAll(d)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read JSON: %v", err)
+		}
+		return data, nil
+	case nil:
+		return nil, errors.New("no JSON document")
+	}
+	return nil, fmt.Errorf("unsupported JSON document type %T", doc)
+}
+
+// encodeJSON encodes a Go value and decodes it again for comparisons
+// with decoded documents.
+func encodeJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode expected value: %v", err)
+	}
+	return decodeJSON(data)
+}
+
+// naturalJSON converts the JSON numbers of a decoded value into int
+// or float64.
+func naturalJSON(v any) any {
+	switch tv := v.(type) {
+	case json.Number:
+		if i, err := strconv.Atoi(string(tv)); err == nil {
+			return i
+		}
+		f, _ := tv.Float64()
+		return f
+	case []any:
+		values := make([]any, len(tv))
+		for i, value := range tv {
+			values[i] = naturalJSON(value)
+		}
+		return values
+	case map[string]any:
+		values := make(map[string]any, len(tv))
+		for k, value := range tv {
+			values[k] = naturalJSON(value)
+		}
+		return values
+	}
+	return v
+}
+
+// jsonPathFailure reports a failure at a JSON path with an optional
+// explanation.
+func jsonPathFailure(t T, verification, path string, expected, got any, details string, infos []string) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	reportFailure(t, failure{
+		verification: verification,
+		path:         path,
+		expected:     expected,
+		got:          got,
+		details:      details,
+		infos:        infos,
+	})
+}
+
+// fexpected formats an expected value or matcher.
+func fexpected(expected any) string {
+	if m, ok := expected.(Matcher); ok {
+		return m.Describe()
+	}
+	if data, err := json.Marshal(expected); err == nil {
+		return string(data)
+	}
+	return fmt.Sprintf("%v", expected)
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
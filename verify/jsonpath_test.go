@@ -0,0 +1,95 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for JSON path verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestJSONPath tests the verifications of values at JSON paths.
+func TestJSONPath(t *testing.T) {
+	body := []byte(`{
+		"total": 3,
+		"items": [
+			{"name": "x", "price": 9.5, "tags": ["new"]},
+			{"name": "y", "price": 12, "tags": []},
+			{"name": "z", "price": 100, "tags": null}
+		],
+		"meta": {"a.b": true, "page": {"size": 3}}
+	}`)
+
+	// Positive test cases with regular testing.T
+	verify.JSONPath(t, body, "$.items[0].name", "x")
+	verify.JSONPath(t, string(body), "$.items", verify.WithLength(3))
+	verify.JSONPath(t, strings.NewReader(string(body)), "$.total", verify.EqualTo(3))
+	verify.JSONPath(t, json.RawMessage(body), "$.items[-1].price", 100.0)
+	verify.JSONPath(t, body, "$.items[1].price", verify.AllOf(verify.MoreThan(10), verify.LessThan(20)))
+	verify.JSONPath(t, body, "$['meta']['a.b']", true)
+	verify.JSONPath(t, body, `$.meta["page"].size`, 3)
+	verify.JSONPath(t, body, "$.items[0].tags", []string{"new"})
+	verify.JSONPath(t, body, "$.items[2].tags", nil)
+	verify.JSONPath(t, body, "$.meta.page", map[string]int{"size": 3})
+	verify.JSONPathExists(t, body, "$.items[2].tags")
+	verify.JSONPathNotExists(t, body, "$.items[3]")
+	verify.JSONPathNotExists(t, body, "$.meta.owner")
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.JSONPath(ct, body, "$.items[0].name", "y")
+	verify.JSONPath(ct, body, "$.items", verify.WithLength(2))
+	verify.JSONPath(ct, body, "$.items[5].name", "x")
+	verify.JSONPath(ct, body, "items[0]", "x")
+	verify.JSONPath(ct, `{"a": `, "$.a", 1)
+	verify.JSONPath(ct, 42, "$.a", 1)
+	verify.JSONPathExists(ct, body, "$.items[0].color")
+	verify.JSONPathNotExists(ct, body, "$.total")
+
+	verify.FailedVerifications(ct,
+		"has JSON path value", "has JSON path value", "has JSON path value", "has JSON path value",
+		"has JSON path value", "has JSON path value", "has JSON path", "not has JSON path")
+}
+
+// TestJSONPathErrors tests the reporting of failures at JSON paths.
+func TestJSONPathErrors(t *testing.T) {
+	rec := verify.NewRecorder()
+	body := `{"items": [{"name": "x", "tags": ["a", "b"]}], "total": 1}`
+
+	verify.JSONPath(rec, body, "$.items[0].name", "y")
+	verify.JSONPath(rec, body, "$.items[0].tags", verify.WithLength(3))
+	verify.JSONPath(rec, body, "$.items[1].name", "x")
+	verify.JSONPath(rec, body, "$.items.name", "x")
+	verify.JSONPath(rec, body, "$.total.value", 1)
+	verify.JSONPath(rec, body, "$.items[x]", "x")
+	verify.JSONPathExists(rec, body, "$.items[0].price")
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "has JSON path value" verification of 'body' at '$.items[0].name': got '"x"', expected '"y"'`,
+		"fail \"has JSON path value\" verification of 'body' at '$.items[0].tags': got '[\"a\",\"b\"]', expected 'has length 3'\n" +
+			"got length 2",
+		"fail \"has JSON path value\" verification of 'body' at '$.items[1].name': got '<missing>', expected '\"x\"'\n" +
+			"index 1 out of range at '$.items' with length 1",
+		"fail \"has JSON path value\" verification of 'body' at '$.items.name': got '<missing>', expected '\"x\"'\n" +
+			"cannot select key 'name' of array at '$.items'",
+		"fail \"has JSON path value\" verification of 'body' at '$.total.value': got '<missing>', expected '1'\n" +
+			"cannot select '.value' of '1' at '$.total'",
+		`fail "has JSON path value" verification of 'body': got 'invalid path '$.items[x]': invalid index 'x'', expected '"x"'`,
+		"fail \"has JSON path\" verification of 'body' at '$.items[0].price': got '<missing>', expected 'existing path'\n" +
+			"key 'price' not found at '$.items[0]'",
+	})
+}
+
+// EOF
@@ -0,0 +1,258 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of maps
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// HasKey checks if the gotten map contains the expected key.
+func HasKey[M ~map[K]V, K comparable, V any](t T, gotten M, expected K, infos ...string) bool {
+	if _, ok := gotten[expected]; !ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "has key", expected, fkeys(keysOf(gotten)), infos...)
+		return false
+	}
+	return true
+}
+
+// HasKeys checks if the gotten map contains all expected keys. The
+// missing ones are reported.
+func HasKeys[M ~map[K]V, K comparable, V any](t T, gotten M, expected []K, infos ...string) bool {
+	var missing []K
+	for _, k := range expected {
+		if _, ok := gotten[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		mapFailure(t, "has keys", fkeys(expected), fkeys(keysOf(gotten)), infos, missing, nil, nil)
+		return false
+	}
+	return true
+}
+
+// NotHasKey checks if the gotten map does not contain the key. It's
+// the opposite of HasKey.
+func NotHasKey[M ~map[K]V, K comparable, V any](t T, gotten M, expected K, infos ...string) bool {
+	if _, ok := gotten[expected]; ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "not has key", expected, fkeys(keysOf(gotten)), infos...)
+		return false
+	}
+	return true
+}
+
+// HasEntry checks if the gotten map contains the key with the
+// expected value.
+func HasEntry[M ~map[K]V, K, V comparable](t T, gotten M, key K, expected V, infos ...string) bool {
+	value, ok := gotten[key]
+	if !ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		mapFailure(t, "has entry", fentry(key, expected), fkeys(keysOf(gotten)), infos, []K{key}, nil, nil)
+		return false
+	}
+	if value != expected {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "has entry", fentry(key, expected), fentry(key, value), infos...)
+		return false
+	}
+	return true
+}
+
+// HasEntryWith checks if the gotten map contains the key with the
+// expected value like HasEntry, but the values are compared deeply and
+// configured by the options.
+func HasEntryWith[M ~map[K]V, K comparable, V any](t T, gotten M, key K, expected V, opts []Option, infos ...string) bool {
+	value, ok := gotten[key]
+	if !ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		mapFailure(t, "has entry", fentry(key, expected), fkeys(keysOf(gotten)), infos, []K{key}, nil, nil)
+		return false
+	}
+	if !newComparer(opts...).equal(value, expected) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "has entry", fentry(key, expected), fentry(key, value), infos...)
+		return false
+	}
+	return true
+}
+
+// MapSubset checks if all entries of the gotten map are contained in the
+// expected map with the same values. Extra keys and differing values are
+// reported.
+func MapSubset[M ~map[K]V, K, V comparable](t T, gotten, expected M, infos ...string) bool {
+	_, extra, differing := compareMaps(gotten, expected, equals[V])
+	if len(extra) > 0 || len(differing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		mapFailure(t, "is map subset", expected, gotten, infos, nil, extra, fdiffering(gotten, expected, differing))
+		return false
+	}
+	return true
+}
+
+// MapEqual checks if the gotten and expected maps contain the same keys
+// with the same values. Missing and extra keys as well as differing values
+// are reported in the order of the keys.
+func MapEqual[M ~map[K]V, K, V comparable](t T, gotten, expected M, infos ...string) bool {
+	missing, extra, differing := compareMaps(gotten, expected, equals[V])
+	if len(missing) > 0 || len(extra) > 0 || len(differing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		mapFailure(t, "is map equal", expected, gotten, infos, missing, extra, fdiffering(gotten, expected, differing))
+		return false
+	}
+	return true
+}
+
+// MapEqualWith checks if the gotten and expected maps contain the same
+// keys with the same values like MapEqual, but the values are compared
+// deeply and configured by the options.
+func MapEqualWith[M ~map[K]V, K comparable, V any](t T, gotten, expected M, opts []Option, infos ...string) bool {
+	missing, extra, differing := compareMaps(gotten, expected, func(g, e V) bool {
+		return newComparer(opts...).equal(g, e)
+	})
+	if len(missing) > 0 || len(extra) > 0 || len(differing) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		mapFailure(t, "is map equal", expected, gotten, infos, missing, extra, fdiffering(gotten, expected, differing))
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// mapFailure raises an error listing the missing and extra keys as well
+// as the keys with differing values.
+func mapFailure[K comparable](t T, verification string, expected, got any, infos []string, missing, extra []K, differing []string) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	var details []string
+	if len(missing) > 0 {
+		details = append(details, "missing keys: "+fkeys(missing))
+	}
+	if len(extra) > 0 {
+		details = append(details, "extra keys: "+fkeys(extra))
+	}
+	if len(differing) > 0 {
+		details = append(details, "differing values:\n  "+strings.Join(differing, "\n  "))
+	}
+	reportFailure(t, failure{
+		verification: verification,
+		expected:     expected,
+		got:          got,
+		details:      strings.Join(details, "\n"),
+		infos:        infos,
+	})
+}
+
+// compareMaps returns the sorted keys missing in gotten, the extra
+// keys of gotten, and the keys with values differing according to equal.
+func compareMaps[M ~map[K]V, K comparable, V any](gotten, expected M, equal func(g, e V) bool) ([]K, []K, []K) {
+	var missing, extra, differing []K
+	for k, ev := range expected {
+		gv, ok := gotten[k]
+		switch {
+		case !ok:
+			missing = append(missing, k)
+		case !equal(gv, ev):
+			differing = append(differing, k)
+		}
+	}
+	for k := range gotten {
+		if _, ok := expected[k]; !ok {
+			extra = append(extra, k)
+		}
+	}
+	return sortKeys(missing), sortKeys(extra), sortKeys(differing)
+}
+
+// equals compares two comparable values.
+func equals[V comparable](g, e V) bool {
+	return g == e
+}
+
+// keysOf returns the sorted keys of a map.
+func keysOf[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return sortKeys(keys)
+}
+
+// sortKeys sorts keys to get a deterministic order.
+func sortKeys[K comparable](keys []K) []K {
+	slices.SortFunc(keys, func(a, b K) int {
+		av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+		switch {
+		case lessValue(av, bv):
+			return -1
+		case lessValue(bv, av):
+			return 1
+		}
+		return 0
+	})
+	return keys
+}
+
+// fkeys formats a list of keys.
+func fkeys[K comparable](keys []K) string {
+	fks := make([]string, len(keys))
+	for i, k := range keys {
+		fks[i] = fmt.Sprintf("%#v", k)
+	}
+	return "[" + strings.Join(fks, " ") + "]"
+}
+
+// fentry formats a map entry.
+func fentry[K comparable, V any](key K, value V) string {
+	return fmt.Sprintf("%#v: %v", key, value)
+}
+
+// fdiffering formats the differing values of the keys.
+func fdiffering[M ~map[K]V, K comparable, V any](gotten, expected M, keys []K) []string {
+	lines := make([]string, len(keys))
+	for i, k := range keys {
+		lines[i] = fmt.Sprintf("[%#v]: got '%v', expected '%v'", k, gotten[k], expected[k])
+	}
+	return lines
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,100 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for map verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestMaps tests the map verification functions.
+func TestMaps(t *testing.T) {
+	cfg := map[string]string{
+		"host": "localhost",
+		"port": "8080",
+		"mode": "test",
+	}
+
+	// Positive test cases with regular testing.T
+	verify.HasKey(t, cfg, "host")
+	verify.HasKeys(t, cfg, []string{"host", "port"})
+	verify.NotHasKey(t, cfg, "user")
+	verify.HasEntry(t, cfg, "mode", "test")
+	verify.MapSubset(t, map[string]string{"port": "8080"}, cfg)
+	verify.MapEqual(t, cfg, map[string]string{"mode": "test", "port": "8080", "host": "localhost"})
+	verify.MapEqual(t, map[int]bool{}, nil)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.HasKey(ct, cfg, "user")
+	verify.HasKeys(ct, cfg, []string{"host", "user"})
+	verify.NotHasKey(ct, cfg, "host")
+	verify.HasEntry(ct, cfg, "user", "admin")
+	verify.HasEntry(ct, cfg, "mode", "prod")
+	verify.MapSubset(ct, cfg, map[string]string{"port": "8080"})
+	verify.MapEqual(ct, cfg, map[string]string{"host": "localhost"})
+
+	verify.FailedVerifications(ct,
+		"has key", "has keys", "not has key", "has entry", "has entry",
+		"is map subset", "is map equal")
+}
+
+// TestMapsDetails tests the reporting of the keys explaining the failures.
+func TestMapsDetails(t *testing.T) {
+	rec := verify.NewRecorder()
+	cache := map[int]string{1: "one", 2: "two", 10: "ten", 11: "eleven"}
+
+	verify.MapEqual(rec, cache, map[int]string{1: "one", 2: "zwei", 3: "three", 9: "nine", 10: "zehn"})
+	verify.HasKeys(rec, cache, []int{3, 1, 4})
+	verify.HasEntry(rec, cache, 2, "zwei")
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"is map equal\" verification of 'cache': got 'map[1:one 2:two 10:ten 11:eleven]', expected 'map[1:one 2:zwei 3:three 9:nine 10:zehn]'\n" +
+			"missing keys: [3 9]\n" +
+			"extra keys: [11]\n" +
+			"differing values:\n" +
+			"  [2]: got 'two', expected 'zwei'\n" +
+			"  [10]: got 'ten', expected 'zehn'",
+		"fail \"has keys\" verification of 'cache': got '[1 2 10 11]', expected '[3 1 4]'\n" +
+			"missing keys: [3 4]",
+		"fail \"has entry\" verification of 'cache': got '2: two', expected '2: zwei'",
+	})
+}
+
+// TestMapsMixedKeys tests maps with keys of different types.
+func TestMapsMixedKeys(t *testing.T) {
+	mixed := map[any]int{1: 1, "a": 2, 2.5: 3, true: 4}
+
+	// Positive test cases with regular testing.T
+	verify.HasKey(t, mixed, any("a"))
+	verify.HasKeys(t, mixed, []any{1, "a", 2.5})
+	verify.MapEqual(t, mixed, map[any]int{true: 4, 2.5: 3, "a": 2, 1: 1})
+
+	// Negative test cases with a recorder
+	rec := verify.NewRecorder()
+
+	verify.HasKey(rec, mixed, any("x"))
+	verify.MapEqual(rec, mixed, map[any]int{1: 1, "a": 5, "b": 6})
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"has key\" verification of 'mixed': got '[true 1 2.5 \"a\"]', expected 'x'",
+		"fail \"is map equal\" verification of 'mixed': got 'map[a:2 1:1 2.5:3 true:4]', expected 'map[a:5 b:6 1:1]'\n" +
+			"missing keys: [\"b\"]\n" +
+			"extra keys: [true 2.5]\n" +
+			"differing values:\n" +
+			"  [\"a\"]: got '2', expected '5'",
+	})
+}
+
+// EOF
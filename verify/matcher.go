@@ -0,0 +1,454 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Composable matchers for individual verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Matcher
+// -----------------------------------------------------------------------------
+
+// Matcher checks a value for the verification That. Matchers can be
+// combined to build more complex ones, e.g.
+//
+//	verify.That(t, names, verify.AllOf(
+//	    verify.WithLength(3),
+//	    verify.Each(verify.Matching("^[a-z]+$")),
+//	))
+type Matcher interface {
+	// Describe returns what the matcher expects.
+	Describe() string
+
+	// Match checks the value. In case of a mismatch it returns
+	// false and an explanation why the value does not match.
+	Match(value any) (bool, string)
+}
+
+// matcherFunc implements Matcher based on a description and
+// a match function.
+type matcherFunc struct {
+	describe string
+	match    func(value any) (bool, string)
+}
+
+// Describe implements Matcher.
+func (m matcherFunc) Describe() string {
+	return m.describe
+}
+
+// Match implements Matcher.
+func (m matcherFunc) Match(value any) (bool, string) {
+	return m.match(value)
+}
+
+// newMatcher creates a matcher with the given description and match function.
+func newMatcher(describe string, match func(value any) (bool, string)) Matcher {
+	return matcherFunc{describe, match}
+}
+
+// -----------------------------------------------------------------------------
+// Verification
+// -----------------------------------------------------------------------------
+
+// That checks if the gotten value matches the matcher. In case of a
+// failure the explanation of the mismatch is reported too.
+func That(t T, gotten any, m Matcher, infos ...string) bool {
+	if ok, why := m.Match(gotten); !ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		reportFailure(t, failure{
+			verification: "that",
+			expected:     m.Describe(),
+			got:          gotten,
+			details:      fwhy(gotten, why),
+			infos:        infos,
+		})
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Matchers
+// -----------------------------------------------------------------------------
+
+// EqualTo matches values equal to the expected one. The value has to
+// be of the same type.
+func EqualTo[C comparable](expected C) Matcher {
+	return newMatcher(fmt.Sprintf("is equal to '%v'", expected), func(value any) (bool, string) {
+		v, ok := value.(C)
+		if !ok {
+			return false, ftype(value)
+		}
+		if v != expected {
+			return false, fgot(value)
+		}
+		return true, ""
+	})
+}
+
+// DeepEqualTo matches values deeply equal to the expected one. See
+// DeepEqual for the comparison, which can be configured by the options.
+func DeepEqualTo(expected any, opts ...Option) Matcher {
+	return newMatcher(fmt.Sprintf("is deeply equal to '%v'", expected), func(value any) (bool, string) {
+		c := newComparer(opts...)
+		if !c.equal(value, expected) {
+			d := c.diffs[0]
+			if d.path == "" {
+				return false, fmt.Sprintf("got '%s', expected '%s'", d.gotten, d.expected)
+			}
+			return false, fmt.Sprintf("at '%s' got '%s', expected '%s'", d.path, d.gotten, d.expected)
+		}
+		return true, ""
+	})
+}
+
+// LessThan matches values less than the expected one. The value has to
+// be of the same type. A NaN never matches.
+func LessThan[C cmp.Ordered](expected C) Matcher {
+	return newMatcher(fmt.Sprintf("is less than '%v'", expected), func(value any) (bool, string) {
+		v, ok := value.(C)
+		if !ok {
+			return false, ftype(value)
+		}
+		if !(v < expected) {
+			return false, fgot(value)
+		}
+		return true, ""
+	})
+}
+
+// MoreThan matches values more than the expected one. The value has to
+// be of the same type. A NaN never matches.
+func MoreThan[C cmp.Ordered](expected C) Matcher {
+	return newMatcher(fmt.Sprintf("is more than '%v'", expected), func(value any) (bool, string) {
+		v, ok := value.(C)
+		if !ok {
+			return false, ftype(value)
+		}
+		if !(v > expected) {
+			return false, fgot(value)
+		}
+		return true, ""
+	})
+}
+
+// Matching matches strings, byte slices, and fmt.Stringer matching
+// the regular expression.
+func Matching(pattern string) Matcher {
+	return newMatcher(fmt.Sprintf("matches '%s'", pattern), func(value any) (bool, string) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, err.Error()
+		}
+		var s string
+		switch v := value.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		case fmt.Stringer:
+			s = v.String()
+		default:
+			return false, ftype(value)
+		}
+		if !re.MatchString(s) {
+			return false, fgot(value)
+		}
+		return true, ""
+	})
+}
+
+// Containing matches strings containing the expected substring as well as
+// slices, arrays, and sequences containing an element deeply equal to the
+// expected one. The options configure the comparison of the elements.
+func Containing(expected any, opts ...Option) Matcher {
+	return newMatcher(fmt.Sprintf("contains '%v'", expected), func(value any) (bool, string) {
+		if s, ok := value.(string); ok {
+			sub, ok := expected.(string)
+			if !ok {
+				return false, fmt.Sprintf("cannot search %T in string", expected)
+			}
+			if !strings.Contains(s, sub) {
+				return false, fgot(value)
+			}
+			return true, ""
+		}
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Array, reflect.Slice:
+			for i := 0; i < rv.Len(); i++ {
+				if newComparer(opts...).equal(rv.Index(i).Interface(), expected) {
+					return true, ""
+				}
+			}
+			return false, fgot(value)
+		}
+		if isSeq(rv) {
+			found := false
+			exceeded := walkSeq(rv, seqLimit(), func(_, v reflect.Value) bool {
+				found = newComparer(opts...).equal(v.Interface(), expected)
+				return !found
+			})
+			if exceeded {
+				return false, fgot(fexceeded())
+			}
+			if !found {
+				return false, "got sequence without it"
+			}
+			return true, ""
+		}
+		return false, ftype(value)
+	})
+}
+
+// WithLength matches values having the expected length. See Length for
+// the supported types.
+func WithLength(expected int) Matcher {
+	return newMatcher(fmt.Sprintf("has length %d", expected), func(value any) (bool, string) {
+		l := flexlen(value)
+		if l < 0 {
+			return false, fmt.Sprintf("got '%v' which is not quantifiable", value)
+		}
+		if l != expected {
+			return false, fmt.Sprintf("got length %d", l)
+		}
+		return true, ""
+	})
+}
+
+// IsEmpty matches values with a length of zero. See Length for the
+// supported types.
+func IsEmpty() Matcher {
+	return newMatcher("is empty", func(value any) (bool, string) {
+		if empty, ok := seqEmpty(value); ok {
+			if !empty {
+				return false, "got non-empty sequence"
+			}
+			return true, ""
+		}
+		l := flexlen(value)
+		if l < 0 {
+			return false, fmt.Sprintf("got '%v' which is not quantifiable", value)
+		}
+		if l != 0 {
+			return false, fmt.Sprintf("got length %d", l)
+		}
+		return true, ""
+	})
+}
+
+// IsNil matches nil as well as nil pointers, slices, maps, channels,
+// functions, and interfaces.
+func IsNil() Matcher {
+	return newMatcher("is nil", func(value any) (bool, string) {
+		if value == nil {
+			return true, ""
+		}
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+			if rv.IsNil() {
+				return true, ""
+			}
+		}
+		return false, fgot(value)
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Combinators
+// -----------------------------------------------------------------------------
+
+// AllOf matches if all of the passed matchers match.
+func AllOf(ms ...Matcher) Matcher {
+	return newMatcher(fdescribe(ms, " and "), func(value any) (bool, string) {
+		var whys []string
+		for _, m := range ms {
+			if ok, why := m.Match(value); !ok {
+				whys = append(whys, fmismatch(m, why))
+			}
+		}
+		if len(whys) > 0 {
+			return false, strings.Join(whys, "\n")
+		}
+		return true, ""
+	})
+}
+
+// AnyOf matches if at least one of the passed matchers matches.
+func AnyOf(ms ...Matcher) Matcher {
+	return newMatcher(fdescribe(ms, " or "), func(value any) (bool, string) {
+		var whys []string
+		for _, m := range ms {
+			ok, why := m.Match(value)
+			if ok {
+				return true, ""
+			}
+			whys = append(whys, fmismatch(m, why))
+		}
+		return false, strings.Join(whys, "\n")
+	})
+}
+
+// Not matches if the passed matcher does not match.
+func Not(m Matcher) Matcher {
+	return newMatcher("not "+m.Describe(), func(value any) (bool, string) {
+		if ok, _ := m.Match(value); ok {
+			return false, fgot(value)
+		}
+		return true, ""
+	})
+}
+
+// Each matches slices, arrays, maps, and sequences whose elements all
+// match the passed matcher. Map values are checked in the order of their
+// sorted keys, the values of an iter.Seq2 in the order of the sequence.
+func Each(m Matcher) Matcher {
+	return newMatcher("each element "+m.Describe(), func(value any) (bool, string) {
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Array, reflect.Slice:
+			for i := 0; i < rv.Len(); i++ {
+				if ok, why := m.Match(rv.Index(i).Interface()); !ok {
+					return false, fnested(fmt.Sprintf("element [%d]", i), why)
+				}
+			}
+			return true, ""
+		case reflect.Map:
+			for _, k := range sortedKeys(rv) {
+				if ok, why := m.Match(rv.MapIndex(k).Interface()); !ok {
+					return false, fnested(fmt.Sprintf("element [%#v]", k), why)
+				}
+			}
+			return true, ""
+		}
+		if isSeq(rv) {
+			var why string
+			exceeded := walkSeq(rv, seqLimit(), func(k, v reflect.Value) bool {
+				ok, w := m.Match(v.Interface())
+				if !ok {
+					why = fnested(fmt.Sprintf("element [%#v]", k.Interface()), w)
+				}
+				return ok
+			})
+			if why != "" {
+				return false, why
+			}
+			if exceeded {
+				return false, fgot(fexceeded())
+			}
+			return true, ""
+		}
+		return false, ftype(value)
+	})
+}
+
+// HavingKey matches maps containing the key with a value matching the
+// passed matcher. It's the matcher counterpart of HasKey.
+func HavingKey(key any, m Matcher) Matcher {
+	return newMatcher(fmt.Sprintf("has key '%v' whose value %s", key, m.Describe()), func(value any) (bool, string) {
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Map {
+			return false, ftype(value)
+		}
+		kv := reflect.ValueOf(key)
+		if !kv.IsValid() || !kv.Type().AssignableTo(rv.Type().Key()) {
+			return false, fmt.Sprintf("key '%v' does not fit to %T", key, value)
+		}
+		ev := rv.MapIndex(kv)
+		if !ev.IsValid() {
+			return false, fmt.Sprintf("key '%v' is missing", key)
+		}
+		if ok, why := m.Match(ev.Interface()); !ok {
+			return false, fnested(fmt.Sprintf("key '%v'", key), why)
+		}
+		return true, ""
+	})
+}
+
+// Field matches structs or pointers to structs having an exported field
+// with the given name whose value matches the passed matcher.
+func Field(name string, m Matcher) Matcher {
+	return newMatcher(fmt.Sprintf("has field '%s' which %s", name, m.Describe()), func(value any) (bool, string) {
+		rv := reflect.ValueOf(value)
+		for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+		if rv.Kind() != reflect.Struct {
+			return false, ftype(value)
+		}
+		fv := rv.FieldByName(name)
+		if !fv.IsValid() {
+			return false, fmt.Sprintf("field '%s' is missing", name)
+		}
+		if !fv.CanInterface() {
+			return false, fmt.Sprintf("field '%s' is not exported", name)
+		}
+		if ok, why := m.Match(fv.Interface()); !ok {
+			return false, fnested(fmt.Sprintf("field '%s'", name), why)
+		}
+		return true, ""
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// fgot formats a gotten value as mismatch explanation.
+func fgot(value any) string {
+	return fmt.Sprintf("got '%v'", value)
+}
+
+// fwhy returns the mismatch explanation for the failure message. It's
+// empty if it only repeats the gotten value, which is already part of it.
+func fwhy(value any, why string) string {
+	if why == fgot(value) {
+		return ""
+	}
+	return why
+}
+
+// ftype formats a gotten value of a wrong type as mismatch explanation.
+func ftype(value any) string {
+	return fmt.Sprintf("got '%v' of unexpected type %T", value, value)
+}
+
+// fdescribe joins the descriptions of matchers.
+func fdescribe(ms []Matcher, sep string) string {
+	descrs := make([]string, len(ms))
+	for i, m := range ms {
+		descrs[i] = m.Describe()
+	}
+	return "(" + strings.Join(descrs, sep) + ")"
+}
+
+// fmismatch formats the mismatch of one of multiple matchers as list entry.
+func fmismatch(m Matcher, why string) string {
+	return "- " + fnested(m.Describe(), why)
+}
+
+// fnested prefixes an explanation. Nested lists and multi-line
+// explanations start on a new indented line.
+func fnested(prefix, why string) string {
+	if strings.HasPrefix(why, "- ") || strings.Contains(why, "\n") {
+		return prefix + ":\n  " + strings.ReplaceAll(why, "\n", "\n  ")
+	}
+	return prefix + ": " + why
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,127 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for matchers
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestThat tests the That verification with the individual matchers.
+func TestThat(t *testing.T) {
+	alice := person{
+		Name:    "Alice",
+		Tags:    []string{"admin", "dev"},
+		Address: &address{"Main Street", "Oldenburg"},
+		Props:   map[string]any{"age": 42},
+	}
+
+	// Positive test cases with regular testing.T
+	verify.That(t, 42, verify.EqualTo(42))
+	verify.That(t, alice, verify.DeepEqualTo(alice))
+	verify.That(t, 5, verify.LessThan(10))
+	verify.That(t, 5.5, verify.MoreThan(1.0))
+	verify.That(t, "hello", verify.Matching("^h.llo$"))
+	verify.That(t, "hello, world", verify.Containing("world"))
+	verify.That(t, []int{1, 2, 3}, verify.Containing(2))
+	verify.That(t, []int{1, 2, 3}, verify.WithLength(3))
+	verify.That(t, map[int]int{}, verify.IsEmpty())
+	verify.That(t, nil, verify.IsNil())
+	verify.That(t, (*person)(nil), verify.IsNil())
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.That(ct, 42, verify.EqualTo(43))
+	verify.That(ct, int64(42), verify.EqualTo(42))
+	verify.That(ct, alice, verify.DeepEqualTo(person{Name: "Alice"}))
+	verify.That(ct, 10, verify.LessThan(10))
+	verify.That(ct, 1.0, verify.MoreThan(1.0))
+	verify.That(ct, "world", verify.Matching("^h"))
+	verify.That(ct, 42, verify.Matching("42"))
+	verify.That(ct, "hello", verify.Containing("world"))
+	verify.That(ct, []int{1, 2, 3}, verify.Containing(4))
+	verify.That(ct, []int{1, 2, 3}, verify.WithLength(2))
+	verify.That(ct, 42, verify.WithLength(2))
+	verify.That(ct, "full", verify.IsEmpty())
+	verify.That(ct, alice, verify.IsNil())
+
+	verify.FailureCount(ct, 13)
+}
+
+// TestThatCombinators tests the That verification with combined matchers.
+func TestThatCombinators(t *testing.T) {
+	alice := person{
+		Name:    "Alice",
+		Tags:    []string{"admin", "dev"},
+		Address: &address{"Main Street", "Oldenburg"},
+		Props:   map[string]any{"age": 42},
+		secret:  7,
+	}
+
+	// Positive test cases with regular testing.T
+	verify.That(t, []string{"a", "bb", "ccc"}, verify.AllOf(
+		verify.WithLength(3),
+		verify.Each(verify.Matching("^[a-c]+$")),
+	))
+	verify.That(t, 5, verify.AnyOf(verify.LessThan(0), verify.EqualTo(5)))
+	verify.That(t, 5, verify.Not(verify.EqualTo(6)))
+	verify.That(t, map[string]int{"a": 1, "b": 2}, verify.Each(verify.LessThan(3)))
+	verify.That(t, map[string]int{"a": 1}, verify.HavingKey("a", verify.EqualTo(1)))
+	verify.That(t, alice, verify.Field("Name", verify.EqualTo("Alice")))
+	verify.That(t, &alice, verify.Field("Address", verify.Field("City", verify.EqualTo("Oldenburg"))))
+	verify.That(t, alice, verify.Field("Props", verify.HavingKey("age", verify.EqualTo(42))))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.That(ct, []int{1, 2}, verify.AllOf(verify.WithLength(2), verify.Each(verify.LessThan(2))))
+	verify.That(ct, 5, verify.AnyOf(verify.LessThan(0), verify.EqualTo(6)))
+	verify.That(ct, 5, verify.Not(verify.EqualTo(5)))
+	verify.That(ct, 5, verify.Each(verify.EqualTo(5)))
+	verify.That(ct, map[string]int{"a": 1}, verify.HavingKey("b", verify.EqualTo(1)))
+	verify.That(ct, map[string]int{"a": 1}, verify.HavingKey(1, verify.EqualTo(1)))
+	verify.That(ct, alice, verify.Field("Age", verify.EqualTo(42)))
+	verify.That(ct, alice, verify.Field("secret", verify.EqualTo(7)))
+	verify.That(ct, "alice", verify.Field("Name", verify.EqualTo("Alice")))
+
+	verify.FailureCount(ct, 9)
+}
+
+// TestThatDescription tests the rendering of nested failure descriptions.
+func TestThatDescription(t *testing.T) {
+	rec := verify.NewRecorder()
+	users := []person{
+		{Name: "Alice", Tags: []string{"admin"}},
+		{Name: "bob", Tags: []string{"dev", "ops"}},
+	}
+
+	verify.That(rec, users, verify.AllOf(
+		verify.WithLength(3),
+		verify.Each(verify.AllOf(
+			verify.Field("Name", verify.Matching("^[A-Z]")),
+			verify.Field("Tags", verify.WithLength(1)),
+		)),
+	))
+	verify.Length(t, rec.Errors(), 1)
+	verify.Equal(t, rec.Errors()[0], `fail "that" verification of 'users': got '[{Alice [admin] <nil> map[] 0} {bob [dev ops] <nil> map[] 0}]', `+
+		`expected '(has length 3 and each element (has field 'Name' which matches '^[A-Z]' and has field 'Tags' which has length 1))'
+- has length 3: got length 2
+- each element (has field 'Name' which matches '^[A-Z]' and has field 'Tags' which has length 1):
+  element [1]:
+    - has field 'Name' which matches '^[A-Z]': field 'Name': got 'bob'
+    - has field 'Tags' which has length 1: field 'Tags': got length 2`)
+}
+
+// EOF
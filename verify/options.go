@@ -0,0 +1,259 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Options of the deep comparison
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// -----------------------------------------------------------------------------
+// Options
+// -----------------------------------------------------------------------------
+
+// Option configures the deep comparison of values. The verifications
+// comparing values have variants with the suffix With taking options,
+// like DeepEqualWith, NotDeepEqualWith, ElementsMatchWith, HasEntryWith,
+// and MapEqualWith, as well as the matchers DeepEqualTo and Containing.
+// So verifications taking a matcher as expected value, like That,
+// PanicsWithValue, or JSONPath, can use options too. The differences
+// shown in failure messages respect the options.
+type Option func(c *comparer)
+
+// FloatTolerance lets floats be equal if their difference is at most
+// the tolerance. It applies to all floats inside of the compared values,
+// e.g. in nested fields, slices, and maps.
+func FloatTolerance(tolerance float64) Option {
+	return func(c *comparer) {
+		if tolerance > 0 {
+			c.floatTolerance = tolerance
+		}
+	}
+}
+
+// TimeTolerance lets times be equal if they differ by at most the
+// tolerance. Their locations and monotonic clock readings are ignored.
+// It applies to all times inside of the compared values.
+func TimeTolerance(tolerance time.Duration) Option {
+	return func(c *comparer) {
+		if tolerance > 0 {
+			c.timeTolerance = tolerance
+		}
+	}
+}
+
+// IgnoreFields lets the comparison skip struct fields. The paths are the
+// field names separated by dots, indexes and map keys are left out. A path
+// matches a field if it's equal to the end of its full path. So "ID" skips
+// all fields named ID, while "Address.City" only skips the fields City of
+// fields named Address.
+func IgnoreFields(paths ...string) Option {
+	return func(c *comparer) {
+		c.ignoredFields = append(c.ignoredFields, paths...)
+	}
+}
+
+// IgnoreUnexported lets the comparison skip all unexported struct fields.
+func IgnoreUnexported() Option {
+	return func(c *comparer) {
+		c.ignoreUnexported = true
+	}
+}
+
+// EquateEmpty lets nil and empty slices as well as nil and empty maps
+// be equal.
+func EquateEmpty() Option {
+	return func(c *comparer) {
+		c.equateEmpty = true
+	}
+}
+
+// SortSlices lets the comparison sort all slices of elements of type E
+// before their elements are compared. So their order doesn't matter. The
+// comparator works like for SortedFunc. Reported indexes are those of the
+// sorted slices.
+func SortSlices[E any](compare func(a, b E) int) Option {
+	return func(c *comparer) {
+		if c.sorters == nil {
+			c.sorters = make(map[reflect.Type]func(s reflect.Value) reflect.Value)
+		}
+		c.sorters[reflect.TypeFor[E]()] = func(s reflect.Value) reflect.Value {
+			elements := make([]E, s.Len())
+			for i := range elements {
+				element, _ := interfaceOf(s.Index(i))
+				elements[i], _ = element.(E)
+			}
+			slices.SortStableFunc(elements, compare)
+			return reflect.ValueOf(elements)
+		}
+	}
+}
+
+// Comparer lets the comparison use the passed function for all values
+// of type T instead of comparing them deeply. If T is an interface, nil
+// values are passed as its zero value.
+func Comparer[T any](equal func(a, b T) bool) Option {
+	return func(c *comparer) {
+		if c.comparers == nil {
+			c.comparers = make(map[reflect.Type]func(g, e any) bool)
+		}
+		c.comparers[reflect.TypeFor[T]()] = func(g, e any) bool {
+			gt, _ := g.(T)
+			et, _ := e.(T)
+			return equal(gt, et)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// timeType is the type of times handled by the time tolerance.
+var timeType = reflect.TypeOf(time.Time{})
+
+// floatsEqual compares two float values with the float tolerance.
+func (c *comparer) floatsEqual(g, e reflect.Value) bool {
+	return math.Abs(g.Float()-e.Float()) <= c.floatTolerance
+}
+
+// timesEqual compares two times with the time tolerance.
+func (c *comparer) timesEqual(gt, et time.Time) bool {
+	d := gt.Sub(et)
+	if d < 0 {
+		d = -d
+	}
+	return d <= c.timeTolerance
+}
+
+// timesOf returns the times of two reflected values. It's false if
+// they cannot be accessed.
+func timesOf(g, e reflect.Value) (time.Time, time.Time, bool) {
+	gt, gok := timeOf(g)
+	et, eok := timeOf(e)
+	return gt, et, gok && eok
+}
+
+// timeOf returns the time of a reflected value, even if it has been
+// reached through unexported fields.
+func timeOf(v reflect.Value) (time.Time, bool) {
+	t, ok := interfaceOf(v)
+	if !ok {
+		return time.Time{}, false
+	}
+	return t.(time.Time), true
+}
+
+// interfaceOf returns the value of a reflected value, even if it has
+// been reached through unexported fields. This is only possible for
+// addressable values.
+func interfaceOf(v reflect.Value) (any, bool) {
+	if v.CanInterface() {
+		return v.Interface(), true
+	}
+	if v.CanAddr() {
+		return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem().Interface(), true
+	}
+	return nil, false
+}
+
+// ignored checks if the field at the given path shall be skipped.
+func (c *comparer) ignored(field reflect.StructField, path string) bool {
+	if c.ignoreUnexported && !field.IsExported() {
+		return true
+	}
+	if len(c.ignoredFields) == 0 {
+		return false
+	}
+	fpath := fieldPath(path)
+	for _, ignored := range c.ignoredFields {
+		ignored = strings.TrimPrefix(ignored, ".")
+		if fpath == ignored || strings.HasSuffix(fpath, "."+ignored) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareWith compares two values with a registered comparer. It's
+// false if there's none or the values cannot be accessed.
+func (c *comparer) compareWith(path string, g, e reflect.Value) bool {
+	equal, ok := c.comparers[g.Type()]
+	if !ok {
+		return false
+	}
+	gv, gok := interfaceOf(g)
+	ev, eok := interfaceOf(e)
+	if !gok || !eok {
+		return false
+	}
+	if !equal(gv, ev) {
+		c.differ(path, fval(g), fval(e))
+	}
+	return true
+}
+
+// bothEmpty checks if two slices or maps are empty and if they shall be
+// equal then, even if only one of them is nil.
+func (c *comparer) bothEmpty(g, e reflect.Value) bool {
+	return c.equateEmpty && g.Len() == 0 && e.Len() == 0
+}
+
+// sorted returns the slices sorted if there's a sorter for their elements.
+func (c *comparer) sorted(g, e reflect.Value) (reflect.Value, reflect.Value) {
+	sort, ok := c.sorters[g.Type().Elem()]
+	if !ok {
+		return g, e
+	}
+	return sort(g), sort(e)
+}
+
+// fieldPath removes the indexes and map keys from a path of the comparer
+// as well as the leading dot.
+func fieldPath(path string) string {
+	var sb strings.Builder
+	depth, quoted, escaped := 0, false, false
+	for _, r := range path {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0:
+			sb.WriteRune(r)
+		}
+	}
+	return strings.TrimPrefix(sb.String(), ".")
+}
+
+// addressable returns an addressable copy of the value, so that the
+// values of its unexported fields can be accessed. Values reached through
+// unexported fields cannot be copied, they are returned unchanged and the
+// options don't apply to their fields.
+func addressable(v reflect.Value) reflect.Value {
+	if !v.IsValid() || v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	a := reflect.New(v.Type()).Elem()
+	a.Set(v)
+	return a
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,311 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for the options of the deep comparison
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Types
+// -----------------------------------------------------------------------------
+
+// sample is a calculated measurement.
+type sample struct {
+	Value float64
+	Taken time.Time
+}
+
+// order is a domain struct with generated fields.
+type order struct {
+	ID        int
+	CreatedAt time.Time
+	Customer  customer
+	Items     []item
+	Tags      map[string]string
+	revision  int
+}
+
+// customer is the customer of an order.
+type customer struct {
+	ID   int
+	Name string
+}
+
+// item is an item of an order.
+type item struct {
+	SKU    string
+	Amount int
+}
+
+// series contains samples in nested structures.
+type series struct {
+	Name    string
+	Samples []sample
+	Peaks   map[string]sample
+	created time.Time
+	weight  float32
+}
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestToleranceOptions tests the float and time tolerances of the
+// deep comparison.
+func TestToleranceOptions(t *testing.T) {
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	expected := series{
+		Name:    "temperatures",
+		Samples: []sample{{20.1, base}, {20.3, base.Add(time.Minute)}},
+		Peaks:   map[string]sample{"max": {20.3, base.Add(time.Minute)}},
+		created: base,
+		weight:  0.5,
+	}
+	calculated := series{
+		Name:    "temperatures",
+		Samples: []sample{{20.1 + 1e-12, base.Add(3 * time.Microsecond)}, {20.3, base.Add(time.Minute).Local()}},
+		Peaks:   map[string]sample{"max": {20.3 - 1e-12, base.Add(time.Minute - time.Microsecond)}},
+		created: base.Add(time.Microsecond),
+		weight:  0.5000001,
+	}
+	tolerances := []verify.Option{verify.FloatTolerance(1e-6), verify.TimeTolerance(10 * time.Microsecond)}
+
+	// Positive test cases with regular testing.T
+	verify.DeepEqualWith(t, calculated, expected, tolerances)
+	verify.DeepEqualWith(t, &calculated, &expected, tolerances)
+	verify.DeepEqualWith(t, []float64{1.0, 2.0}, []float64{1.05, 1.95}, []verify.Option{verify.FloatTolerance(0.1)})
+	verify.DeepEqualWith(t, expected, expected, nil)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.DeepEqual(ct, calculated, expected)
+	verify.DeepEqualWith(ct, calculated, expected, []verify.Option{verify.FloatTolerance(1e-6)})
+	verify.DeepEqualWith(ct, calculated, expected, []verify.Option{verify.TimeTolerance(10 * time.Microsecond)})
+	verify.DeepEqualWith(ct, calculated, expected, []verify.Option{verify.FloatTolerance(1e-15), verify.TimeTolerance(time.Second)})
+	verify.DeepEqualWith(ct, calculated, expected, []verify.Option{verify.FloatTolerance(1e-6), verify.TimeTolerance(time.Nanosecond)})
+
+	verify.FailureCount(ct, 5)
+
+	// Reporting of the differences outside of the tolerances.
+	rec := verify.NewRecorder()
+	verify.DeepEqualWith(rec, calculated, expected, []verify.Option{verify.FloatTolerance(1e-6), verify.TimeTolerance(2 * time.Microsecond)})
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"is deeply equal\" verification of 'calculated': got and expected differ\n" +
+			"--- expected\n+++ got\n" +
+			".Samples[0].Taken: got '2025-03-01T12:00:00.000003Z', expected '2025-03-01T12:00:00Z'",
+	})
+}
+
+// TestComparisonOptions tests the options to ignore fields, equate empty
+// values, sort slices, and compare with custom functions.
+func TestComparisonOptions(t *testing.T) {
+	stored := order{
+		ID:        4711,
+		CreatedAt: time.Now(),
+		Customer:  customer{ID: 1, Name: "Alice"},
+		Items:     []item{{"b-2", 1}, {"a-1", 3}},
+		revision:  7,
+	}
+	expected := order{
+		Customer: customer{Name: "Alice"},
+		Items:    []item{{"a-1", 3}, {"b-2", 1}},
+		Tags:     map[string]string{},
+	}
+	bySKU := func(a, b item) int { return strings.Compare(a.SKU, b.SKU) }
+	caseless := func(a, b customer) bool { return strings.EqualFold(a.Name, b.Name) }
+	opts := []verify.Option{
+		verify.IgnoreFields("ID", "CreatedAt"),
+		verify.IgnoreUnexported(),
+		verify.EquateEmpty(),
+		verify.SortSlices(bySKU),
+	}
+
+	// Positive test cases with regular testing.T
+	verify.DeepEqualWith(t, stored, expected, opts)
+	verify.DeepEqualWith(t, []order{stored}, []order{expected}, opts)
+	verify.DeepEqualWith(t, []int(nil), []int{}, []verify.Option{verify.EquateEmpty()})
+	verify.DeepEqualWith(t, map[string]int{}, map[string]int(nil), []verify.Option{verify.EquateEmpty()})
+	verify.DeepEqualWith(t, customer{1, "ALICE"}, customer{2, "alice"}, []verify.Option{verify.Comparer(caseless)})
+	verify.DeepEqualWith(t, stored.Customer, expected.Customer, []verify.Option{verify.IgnoreFields(".ID")})
+	verify.NotDeepEqualWith(t, stored, expected, []verify.Option{verify.IgnoreFields("Customer.ID")})
+	verify.That(t, stored, verify.DeepEqualTo(expected, opts...))
+	verify.That(t, []order{stored}, verify.Containing(expected, opts...))
+	verify.That(t, []customer{{1, "ALICE"}}, verify.Containing(customer{2, "alice"}, verify.Comparer(caseless)))
+	verify.PanicsWithValue(t, func() { panic(stored) }, verify.DeepEqualTo(expected, opts...))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.DeepEqualWith(ct, stored, expected, []verify.Option{verify.IgnoreFields("Customer.ID", "CreatedAt")})
+	verify.DeepEqualWith(ct, stored, expected, []verify.Option{verify.IgnoreFields("ID", "CreatedAt", "revision")})
+	verify.DeepEqualWith(ct, stored, expected, []verify.Option{verify.IgnoreFields("ID", "CreatedAt"), verify.IgnoreUnexported()})
+	verify.DeepEqualWith(ct, []int(nil), []int{}, nil)
+	verify.DeepEqualWith(ct, customer{1, "Alice"}, customer{1, "Bob"}, []verify.Option{verify.Comparer(caseless)})
+	verify.NotDeepEqualWith(ct, stored, expected, opts)
+	verify.That(ct, stored, verify.DeepEqualTo(expected))
+	verify.That(ct, []order{stored}, verify.Containing(expected))
+	verify.PanicsWithValue(ct, func() { panic(stored) }, verify.DeepEqualTo(expected))
+
+	verify.FailedVerifications(ct,
+		"is deeply equal", "is deeply equal", "is deeply equal", "is deeply equal",
+		"is deeply equal", "is not deeply equal", "that", "that", "panics with value")
+
+	// Reporting of differences with options.
+	rec := verify.NewRecorder()
+	stored.Items[0].Amount = 2
+	verify.DeepEqualWith(rec, stored, expected, opts)
+	verify.DeepEqualWith(rec, stored.Items[0], expected.Items[1], nil, "first item")
+	verify.NotDeepEqualWith(rec, stored.Customer, expected.Customer, []verify.Option{verify.IgnoreFields("ID")}, "customer")
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"is deeply equal\" verification of 'stored': got and expected differ\n" +
+			"--- expected\n+++ got\n" +
+			".Items[1].Amount: got '2', expected '1'",
+		`fail "is deeply equal" verification of 'stored.Items[0]' at '.Amount': got '2', expected '1' (first item)`,
+		`fail "is not deeply equal" verification of 'stored.Customer': got '{1 Alice}', expected '{0 Alice}' (customer)`,
+	})
+}
+
+// TestCollectionOptions tests the options in the verifications of
+// slices and maps.
+func TestCollectionOptions(t *testing.T) {
+	stored := []customer{{1, "Alice"}, {2, "Bob"}, {3, "Bob"}}
+	expected := []customer{{0, "bob"}, {0, "alice"}, {0, "bob"}}
+	caseless := func(a, b customer) bool { return strings.EqualFold(a.Name, b.Name) }
+	opts := []verify.Option{verify.Comparer(caseless)}
+	carts := map[string][]item{"alice": {{"a-1", 1}}, "bob": nil}
+
+	// Positive test cases with regular testing.T
+	verify.ElementsMatchWith(t, stored, expected, opts)
+	verify.ElementsMatchWith(t, [][]int{{1}, {2, 3}}, [][]int{{2, 3}, {1}}, nil)
+	verify.ElementsMatchWith(t, []float64{1.05, 0.95}, []float64{1.0, 1.1}, []verify.Option{verify.FloatTolerance(0.1)})
+	verify.ElementsMatchWith(t, []float64{1.0, 1.1, 1.2}, []float64{1.15, 1.05, 0.95}, []verify.Option{verify.FloatTolerance(0.1)})
+	verify.HasEntryWith(t, carts, "alice", []item{{"a-1", 1}}, nil)
+	verify.HasEntryWith(t, carts, "bob", []item{}, []verify.Option{verify.EquateEmpty()})
+	verify.MapEqualWith(t, carts, map[string][]item{"alice": {{"a-1", 1}}, "bob": {}}, []verify.Option{verify.EquateEmpty()})
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.ElementsMatchWith(ct, stored, expected, nil)
+	verify.ElementsMatchWith(ct, stored, expected[1:], opts)
+	verify.HasEntryWith(ct, carts, "carol", nil, nil)
+	verify.HasEntryWith(ct, carts, "bob", []item{}, nil)
+	verify.MapEqualWith(ct, carts, map[string][]item{"alice": {{"a-1", 1}}, "bob": {}}, nil)
+
+	verify.FailedVerifications(ct,
+		"elements match", "elements match", "has entry", "has entry", "is map equal")
+
+	// Reporting of the elements and keys explaining the failures.
+	rec := verify.NewRecorder()
+	verify.ElementsMatchWith(rec, stored, expected[1:], opts, "customers")
+	verify.ElementsMatchWith(rec, []float64{1.05, 0.95, 2.0}, []float64{1.0, 1.1, 3.0}, []verify.Option{verify.FloatTolerance(0.1)}, "overlapping")
+	verify.MapEqualWith(rec, carts, map[string][]item{"alice": {{"a-1", 2}}, "carol": nil}, nil)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"elements match\" verification of 'stored': got '[{1 Alice} {2 Bob} {3 Bob}]', " +
+			"expected '[{0 alice} {0 bob}]' (customers)\nunexpected: [{3 Bob}]",
+		"fail \"elements match\" verification: got '[1.05 0.95 2]', " +
+			"expected '[1 1.1 3]' (overlapping)\nmissing: [3]\nunexpected: [2]",
+		"fail \"is map equal\" verification of 'carts': got 'map[alice:[{a-1 1}] bob:[]]', " +
+			"expected 'map[alice:[{a-1 2}] carol:[]]'\nmissing keys: [\"carol\"]\nextra keys: [\"bob\"]\n" +
+			"differing values:\n  [\"alice\"]: got '[{a-1 1}]', expected '[{a-1 2}]'",
+	})
+}
+
+// TestComparerUnexported tests the options on values reached through
+// unexported fields.
+func TestComparerUnexported(t *testing.T) {
+	type wrapper struct {
+		inner customer
+		items []item
+	}
+	caseless := func(a, b customer) bool { return strings.EqualFold(a.Name, b.Name) }
+	bySKU := func(a, b item) int { return strings.Compare(a.SKU, b.SKU) }
+
+	verify.DeepEqualWith(t,
+		wrapper{customer{1, "ALICE"}, []item{{"b", 1}, {"a", 2}}},
+		wrapper{customer{1, "alice"}, []item{{"a", 2}, {"b", 1}}},
+		[]verify.Option{verify.Comparer(caseless), verify.SortSlices(bySKU)})
+}
+
+// TestOptionsThroughMapsAndInterfaces tests the options on unexported
+// fields of values reached through maps and interfaces.
+func TestOptionsThroughMapsAndInterfaces(t *testing.T) {
+	type event struct {
+		at    time.Time
+		owner customer
+	}
+	now := time.Now()
+	caseless := func(a, b customer) bool { return strings.EqualFold(a.Name, b.Name) }
+	opts := []verify.Option{verify.TimeTolerance(time.Millisecond), verify.Comparer(caseless)}
+	gotten := event{now.Add(time.Microsecond), customer{1, "ALICE"}}
+	expected := event{now, customer{1, "alice"}}
+
+	// Positive test cases with regular testing.T
+	verify.DeepEqualWith(t, map[string]event{"a": gotten}, map[string]event{"a": expected}, opts)
+	verify.DeepEqualWith(t, []any{gotten}, []any{expected}, opts)
+	verify.DeepEqualWith(t, map[string]any{"a": gotten}, map[string]any{"a": expected}, opts)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	late := event{now.Add(time.Second), customer{1, "alice"}}
+	verify.DeepEqualWith(ct, map[string]event{"a": late}, map[string]event{"a": expected}, opts)
+	verify.DeepEqualWith(ct, []any{late}, []any{expected}, opts)
+
+	verify.FailedVerifications(ct, "is deeply equal", "is deeply equal")
+
+	// Reporting of the differing time instead of its internal fields.
+	rec := verify.NewRecorder()
+	verify.DeepEqualWith(rec, map[string]event{"a": late}, map[string]event{"a": expected}, opts)
+
+	verify.Length(t, rec.Errors(), 1)
+	verify.Substring(t, `at '["a"].at': got '`, rec.Errors()[0])
+}
+
+// TestComparerNilInterface tests a comparer for an interface type with
+// nil values.
+func TestComparerNilInterface(t *testing.T) {
+	type result struct {
+		Err error
+	}
+	sameMessage := func(a, b error) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Error() == b.Error()
+	}
+	opts := []verify.Option{verify.Comparer(sameMessage)}
+
+	// Positive test cases with regular testing.T
+	verify.DeepEqualWith(t, result{}, result{}, opts)
+	verify.DeepEqualWith(t, result{errors.New("x")}, result{errors.New("x")}, opts)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.DeepEqualWith(ct, result{}, result{errors.New("x")}, opts)
+	verify.DeepEqualWith(ct, result{errors.New("x")}, result{}, opts)
+
+	verify.FailedVerifications(ct, "is deeply equal", "is deeply equal")
+}
+
+// EOF
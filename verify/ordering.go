@@ -0,0 +1,260 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of the ordering of slices and sequences
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// -----------------------------------------------------------------------------
+// Constants
+// -----------------------------------------------------------------------------
+
+const (
+	ascending          = "ascending order"
+	strictlyIncreasing = "strictly increasing order"
+	strictlyDecreasing = "strictly decreasing order"
+	comparatorOrder    = "order of comparator"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications of slices
+// -----------------------------------------------------------------------------
+
+// Sorted checks if the gotten slice is sorted in ascending order. Equal
+// neighbours are allowed. The first out-of-order pair is reported,
+// pairs including a NaN are never in order.
+func Sorted[S ~[]E, E cmp.Ordered](t T, gotten S, infos ...string) bool {
+	if pair, ok := unordered(slices.All(gotten), inOrder[E](notAfter)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is sorted", ascending, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// SortedFunc checks if the gotten slice is sorted according to the
+// comparator, which returns a negative number if a < b, a positive
+// one if a > b, and zero if both are equal. So a descending order
+// can be verified with a reversed comparator.
+func SortedFunc[S ~[]E, E any](t T, gotten S, compare func(a, b E) int, infos ...string) bool {
+	if pair, ok := unordered(slices.All(gotten), inOrderFunc(compare, notAfter)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is sorted", comparatorOrder, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// StrictlyIncreasing checks if each element of the gotten slice is
+// greater than its predecessor. A NaN is never greater or less.
+func StrictlyIncreasing[S ~[]E, E cmp.Ordered](t T, gotten S, infos ...string) bool {
+	if pair, ok := unordered(slices.All(gotten), inOrder[E](before)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is strictly increasing", strictlyIncreasing, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// StrictlyDecreasing checks if each element of the gotten slice is
+// less than its predecessor. A NaN is never greater or less.
+func StrictlyDecreasing[S ~[]E, E cmp.Ordered](t T, gotten S, infos ...string) bool {
+	if pair, ok := unordered(slices.All(gotten), inOrder[E](after)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is strictly decreasing", strictlyDecreasing, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Verifications of sequences
+// -----------------------------------------------------------------------------
+
+// SortedSeq checks if the gotten sequence is sorted in ascending order.
+// Equal neighbours are allowed. The first out-of-order pair is reported
+// with the indexes of the elements. Sequences longer than the sequence
+// limit fail, see SetSeqLimit.
+func SortedSeq[E cmp.Ordered](t T, gotten iter.Seq[E], infos ...string) bool {
+	if pair, ok := unorderedSeq(indexed(gotten), inOrder[E](notAfter)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is sorted", ascending, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// SortedSeqFunc checks if the gotten sequence is sorted according to the
+// comparator. See SortedFunc.
+func SortedSeqFunc[E any](t T, gotten iter.Seq[E], compare func(a, b E) int, infos ...string) bool {
+	if pair, ok := unorderedSeq(indexed(gotten), inOrderFunc(compare, notAfter)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is sorted", comparatorOrder, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// StrictlyIncreasingSeq checks if each element of the gotten sequence
+// is greater than its predecessor.
+func StrictlyIncreasingSeq[E cmp.Ordered](t T, gotten iter.Seq[E], infos ...string) bool {
+	if pair, ok := unorderedSeq(indexed(gotten), inOrder[E](before)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is strictly increasing", strictlyIncreasing, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// StrictlyDecreasingSeq checks if each element of the gotten sequence
+// is less than its predecessor.
+func StrictlyDecreasingSeq[E cmp.Ordered](t T, gotten iter.Seq[E], infos ...string) bool {
+	if pair, ok := unorderedSeq(indexed(gotten), inOrder[E](after)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is strictly decreasing", strictlyDecreasing, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// SortedSeq2 checks if the values of the gotten sequence of key/value pairs
+// are sorted in ascending order. The first out-of-order pair is reported
+// with its keys.
+func SortedSeq2[K any, V cmp.Ordered](t T, gotten iter.Seq2[K, V], infos ...string) bool {
+	if pair, ok := unorderedSeq(gotten, inOrder[V](notAfter)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is sorted", ascending, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// SortedSeq2Func checks if the values of the gotten sequence of key/value
+// pairs are sorted according to the comparator. See SortedFunc.
+func SortedSeq2Func[K, V any](t T, gotten iter.Seq2[K, V], compare func(a, b V) int, infos ...string) bool {
+	if pair, ok := unorderedSeq(gotten, inOrderFunc(compare, notAfter)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is sorted", comparatorOrder, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// StrictlyIncreasingSeq2 checks if each value of the gotten sequence of
+// key/value pairs is greater than its predecessor.
+func StrictlyIncreasingSeq2[K any, V cmp.Ordered](t T, gotten iter.Seq2[K, V], infos ...string) bool {
+	if pair, ok := unorderedSeq(gotten, inOrder[V](before)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is strictly increasing", strictlyIncreasing, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// StrictlyDecreasingSeq2 checks if each value of the gotten sequence of
+// key/value pairs is less than its predecessor.
+func StrictlyDecreasingSeq2[K any, V cmp.Ordered](t T, gotten iter.Seq2[K, V], infos ...string) bool {
+	if pair, ok := unorderedSeq(gotten, inOrder[V](after)); ok {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is strictly decreasing", strictlyDecreasing, pair, infos...)
+		return false
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// notAfter accepts neighbours in ascending order.
+func notAfter(c int) bool { return c <= 0 }
+
+// before accepts neighbours in strictly increasing order.
+func before(c int) bool { return c < 0 }
+
+// after accepts neighbours in strictly decreasing order.
+func after(c int) bool { return c > 0 }
+
+// inOrder returns a check of neighbours comparing them with cmp.Compare.
+// As cmp.Compare sorts a NaN before all numbers, pairs including a NaN
+// are explicitly rejected.
+func inOrder[E cmp.Ordered](accept func(c int) bool) func(a, b E) bool {
+	return func(a, b E) bool {
+		if a != a || b != b {
+			return false
+		}
+		return accept(cmp.Compare(a, b))
+	}
+}
+
+// inOrderFunc returns a check of neighbours comparing them with
+// the comparator.
+func inOrderFunc[E any](compare func(a, b E) int, accept func(c int) bool) func(a, b E) bool {
+	return func(a, b E) bool {
+		return accept(compare(a, b))
+	}
+}
+
+// unordered returns the description of the first pair of neighbours
+// not accepted as being in order.
+func unordered[K, V any](seq iter.Seq2[K, V], ordered func(a, b V) bool) (string, bool) {
+	var prevKey K
+	var prev V
+	first := true
+	for k, v := range seq {
+		if !first && !ordered(prev, v) {
+			return fmt.Sprintf("%v at [%v] before %v at [%v]", prev, prevKey, v, k), true
+		}
+		prevKey, prev, first = k, v, false
+	}
+	return "", false
+}
+
+// unorderedSeq works like unordered but consumes the sequence only up
+// to the sequence limit. Longer sequences are reported as exceeded.
+func unorderedSeq[K, V any](seq iter.Seq2[K, V], ordered func(a, b V) bool) (string, bool) {
+	bounded, exceeded := limited(seq)
+	if pair, ok := unordered(bounded, ordered); ok {
+		return pair, true
+	}
+	if exceeded() {
+		return fexceeded(), true
+	}
+	return "", false
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,106 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for ordering verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestOrderingSlices tests the ordering verifications of slices.
+func TestOrderingSlices(t *testing.T) {
+	type event struct {
+		ID   int
+		Time time.Time
+	}
+	now := time.Now()
+	events := []event{{3, now}, {2, now.Add(time.Second)}, {1, now.Add(time.Minute)}}
+	byTime := func(a, b event) int { return a.Time.Compare(b.Time) }
+	byIDDesc := func(a, b event) int { return cmp.Compare(b.ID, a.ID) }
+
+	// Positive test cases with regular testing.T
+	verify.Sorted(t, []int{1, 2, 2, 3})
+	verify.Sorted(t, []string{"a", "b", "c"})
+	verify.Sorted(t, []float64{})
+	verify.SortedFunc(t, events, byTime)
+	verify.SortedFunc(t, events, byIDDesc)
+	verify.StrictlyIncreasing(t, []int{1, 2, 3})
+	verify.StrictlyDecreasing(t, []int{3, 2, 1})
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Sorted(ct, []int{1, 3, 2})
+	verify.SortedFunc(ct, []event{events[2], events[0], events[1]}, byTime)
+	verify.StrictlyIncreasing(ct, []int{1, 2, 2})
+	verify.StrictlyDecreasing(ct, []int{3, 3, 1})
+
+	verify.FailedVerifications(ct, "is sorted", "is sorted", "is strictly increasing", "is strictly decreasing")
+}
+
+// TestOrderingSeqs tests the ordering verifications of sequences.
+func TestOrderingSeqs(t *testing.T) {
+	ages := map[string]int{"alice": 42, "bob": 23, "carol": 31}
+
+	// Positive test cases with regular testing.T
+	verify.SortedSeq(t, slices.Values([]int{1, 2, 2, 3}))
+	verify.SortedSeq(t, maps.Keys(map[string]bool{}))
+	verify.SortedSeqFunc(t, slices.Values([]int{3, 2, 1}), func(a, b int) int { return b - a })
+	verify.StrictlyIncreasingSeq(t, slices.Values([]int{1, 2, 3}))
+	verify.StrictlyDecreasingSeq(t, slices.Values([]int{3, 2, 1}))
+	verify.SortedSeq2(t, slices.All([]string{"a", "b"}))
+	verify.SortedSeq2Func(t, slices.All([]int{3, 1}), func(a, b int) int { return b - a })
+	verify.StrictlyIncreasingSeq2(t, slices.All([]int{1, 2, 3}))
+	verify.StrictlyDecreasingSeq2(t, slices.All([]string{"c", "b", "a"}))
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.SortedSeq(ct, slices.Values([]int{1, 3, 2}))
+	verify.SortedSeqFunc(ct, slices.Values([]int{1, 2}), func(a, b int) int { return b - a })
+	verify.StrictlyIncreasingSeq(ct, slices.Values([]int{1, 1}))
+	verify.StrictlyDecreasingSeq(ct, slices.Values([]int{1, 2}))
+	verify.SortedSeq2(ct, slices.All([]string{"b", "a"}))
+	verify.SortedSeq2Func(ct, slices.All([]int{1, 3}), func(a, b int) int { return b - a })
+	verify.StrictlyIncreasingSeq2(ct, slices.All([]int{1, 1}))
+	verify.StrictlyDecreasingSeq2(ct, slices.All([]string{"a", "b"}))
+
+	verify.FailureCount(ct, 8)
+
+	// Reporting of the first out-of-order pair.
+	rec := verify.NewRecorder()
+	ids := []int{1, 2, 5, 4, 3}
+	byName := func(yield func(string, int) bool) {
+		for _, name := range []string{"alice", "bob", "carol"} {
+			if !yield(name, ages[name]) {
+				return
+			}
+		}
+	}
+	verify.Sorted(rec, ids)
+	verify.SortedSeq2(rec, byName)
+	verify.StrictlyDecreasingSeq2(rec, byName)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "is sorted" verification of 'ids': got '5 at [2] before 4 at [3]', expected 'ascending order'`,
+		`fail "is sorted" verification of 'byName': got '42 at [alice] before 23 at [bob]', expected 'ascending order'`,
+		`fail "is strictly decreasing" verification of 'byName': got '23 at [bob] before 31 at [carol]', expected 'strictly decreasing order'`,
+	})
+}
+
+// EOF
@@ -0,0 +1,218 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Verifications of panics and their values
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+// Panics checks if the given functions panics.
+func Panics(t T, gotten func()) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	_, ok := catchPanic(t, "panics", gotten, nil)
+	return ok
+}
+
+// NotPanics checks if the given functions does not panic. A failure
+// contains the stack of the panic.
+func NotPanics(t T, gotten func()) bool {
+	if gotten == nil {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "not panics", "expected function", nil)
+		return false
+	}
+	p := protect(gotten)
+	if p.panicked {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		reportFailure(t, failure{
+			verification: "not panics",
+			expected:     "no panic",
+			got:          fpanic(p.value),
+			details:      "panic stack:\n" + strings.TrimSpace(p.stack),
+		})
+		return false
+	}
+	return true
+}
+
+// PanicsWithValue checks if the given function panics with the expected
+// value. The values are compared like DeepEqual does and the path of the
+// first difference is reported. If expected is a Matcher the recovered
+// value is passed to it, so e.g. DeepEqualTo with options can be used.
+func PanicsWithValue(t T, gotten func(), expected any, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	p, ok := catchPanic(t, "panics with value", gotten, infos)
+	if !ok {
+		return false
+	}
+	if m, ok := expected.(Matcher); ok {
+		if ok, why := m.Match(p.value); !ok {
+			reportFailure(t, failure{
+				verification: "panics with value",
+				expected:     m.Describe(),
+				got:          p.value,
+				details:      fwhy(p.value, why),
+				infos:        infos,
+			})
+			return false
+		}
+		return true
+	}
+	c := newComparer()
+	if !c.equal(p.value, expected) {
+		d := c.diffs[0]
+		reportFailure(t, failure{
+			verification: "panics with value",
+			path:         d.path,
+			expected:     d.expected,
+			got:          d.gotten,
+			diff:         renderDiff(expected, p.value),
+			infos:        infos,
+		})
+		return false
+	}
+	return true
+}
+
+// PanicsWithError checks if the given function panics with an error
+// matching the expected one. It uses the errors.Is() function.
+func PanicsWithError(t T, gotten func(), expected error, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	p, ok := catchPanic(t, "panics with error", gotten, infos)
+	if !ok {
+		return false
+	}
+	err, isErr := p.value.(error)
+	if !isErr || !errors.Is(err, expected) {
+		verificationFailure(t, "panics with error", expected, fpanic(p.value), infos...)
+		return false
+	}
+	return true
+}
+
+// PanicsMatching checks if the given function panics with a message
+// matching the expected regular expression. The message of errors is
+// their text, other values are formatted with %v.
+func PanicsMatching(t T, gotten func(), expected string, infos ...string) bool {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	re, err := regexp.Compile(expected)
+	if err != nil {
+		verificationFailure(t, "panics matching", "valid regular expression", err.Error(), infos...)
+		return false
+	}
+	p, ok := catchPanic(t, "panics matching", gotten, infos)
+	if !ok {
+		return false
+	}
+	if !re.MatchString(fpanic(p.value)) {
+		verificationFailure(t, "panics matching", expected, fpanic(p.value), infos...)
+		return false
+	}
+	return true
+}
+
+// PanicValue checks if the given function panics and returns the
+// recovered value for further verifications.
+//
+//	v, ok := verify.PanicValue(t, func() { parse("}") })
+//	if ok {
+//	    verify.Implements(t, v, &syntaxErr)
+//	}
+func PanicValue(t T, gotten func(), infos ...string) (any, bool) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	p, ok := catchPanic(t, "panics", gotten, infos)
+	if !ok {
+		return nil, false
+	}
+	return p.value, true
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// panicking describes the outcome of a function running in a protected
+// frame.
+type panicking struct {
+	panicked bool
+	value    any
+	stack    string
+}
+
+// protect runs the function in an own frame and recovers a panic. The
+// stack is the one of the panicking goroutine at the time of recovering.
+// Failures are reported after protect returned, so a FailNow doesn't
+// interfere with the recovering and the verifications return the outcome
+// for all kinds of T. A runtime.Goexit of the function, e.g. by FailNow
+// inside of it, isn't stopped.
+func protect(f func()) (p panicking) {
+	defer func() {
+		if p.panicked {
+			p.value = recover()
+			p.stack = string(debug.Stack())
+		}
+	}()
+	p.panicked = true
+	f()
+	p.panicked = false
+	return p
+}
+
+// catchPanic runs the function in a protected frame and reports a
+// failure of the verification if the function is nil or does not panic.
+func catchPanic(t T, verification string, f func(), infos []string) (panicking, bool) {
+	if f == nil {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, verification, "expected function", nil, infos...)
+		return panicking{}, false
+	}
+	p := protect(f)
+	if !p.panicked {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, verification, "panic", "no panic", infos...)
+		return p, false
+	}
+	return p, true
+}
+
+// fpanic formats a recovered value as message.
+func fpanic(v any) string {
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,150 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for panic verifications
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestPanics tests the verifications of panics and their values.
+func TestPanics(t *testing.T) {
+	quiet := func() {}
+	loud := func() { panic("boom") }
+	failing := func() { panic(fmt.Errorf("reading config: %w", io.ErrUnexpectedEOF)) }
+	coded := func() { panic(42) }
+
+	// Positive test cases with regular testing.T
+	verify.Panics(t, loud)
+	verify.NotPanics(t, quiet)
+	verify.PanicsWithValue(t, loud, "boom")
+	verify.PanicsWithValue(t, coded, 42)
+	verify.PanicsWithError(t, failing, io.ErrUnexpectedEOF)
+	verify.PanicsMatching(t, failing, `^reading .*: unexpected EOF$`)
+	verify.PanicsMatching(t, coded, `\d+`)
+
+	v, ok := verify.PanicValue(t, failing)
+	verify.True(t, ok)
+	verify.ErrorContains(t, v.(error), "reading config")
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.PanicsWithValue(ct, loud, "bang")
+	verify.PanicsWithValue(ct, coded, int64(42))
+	verify.PanicsWithValue(ct, quiet, "boom")
+	verify.PanicsWithError(ct, failing, io.EOF)
+	verify.PanicsWithError(ct, loud, io.EOF)
+	verify.PanicsMatching(ct, loud, `^bang$`)
+	verify.PanicsMatching(ct, loud, `(`)
+	verify.PanicsMatching(ct, nil, `boom`)
+
+	v, ok = verify.PanicValue(ct, quiet)
+	verify.False(t, ok)
+	verify.Nil(t, v)
+
+	verify.FailedVerifications(ct,
+		"panics with value", "panics with value", "panics with value",
+		"panics with error", "panics with error",
+		"panics matching", "panics matching", "panics matching", "panics")
+}
+
+// TestPanicsMessages tests the failure messages of the panic verifications.
+func TestPanicsMessages(t *testing.T) {
+	rec := verify.NewRecorder()
+	quiet := func() {}
+	loud := func() { panic(errors.New("boom")) }
+	bounded := func() { panic(struct{ Min, Max int }{1, 9}) }
+
+	verify.Panics(rec, quiet)
+	verify.PanicsWithValue(rec, quiet, "boom")
+	verify.PanicsWithValue(rec, bounded, struct{ Min, Max int }{1, 10})
+	verify.PanicsWithError(rec, loud, io.EOF)
+	verify.PanicsMatching(rec, loud, "^bang")
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "panics" verification of 'quiet': got 'no panic', expected 'panic'`,
+		`fail "panics with value" verification of 'quiet': got 'no panic', expected 'panic'`,
+		`fail "panics with value" verification of 'bounded' at '.Max': got '9', expected '10'`,
+		`fail "panics with error" verification of 'loud': got 'boom', expected 'EOF'`,
+		`fail "panics matching" verification of 'loud': got 'boom', expected '^bang'`,
+	})
+
+	rec.Reset()
+	verify.NotPanics(rec, loud)
+
+	errs := rec.Errors()
+	verify.Length(t, errs, 1)
+	header, stack, _ := strings.Cut(errs[0], "\n")
+	verify.Equal(t, header, `fail "not panics" verification of 'loud': got 'boom', expected 'no panic'`)
+	verify.True(t, strings.HasPrefix(stack, "panic stack:\ngoroutine "))
+	verify.Substring(t, "TestPanicsMessages", stack)
+}
+
+// TestPanicsOutcome tests the returned outcome of Panics and NotPanics
+// for the different kinds of T.
+func TestPanicsOutcome(t *testing.T) {
+	quiet := func() {}
+	loud := func() { panic("boom") }
+
+	// Regular testing.T
+	verify.True(t, verify.Panics(t, loud))
+	verify.True(t, verify.NotPanics(t, quiet))
+
+	// Continued testing
+	ct := verify.ContinuedTesting(t)
+	verify.False(t, verify.Panics(ct, quiet))
+	verify.False(t, verify.NotPanics(ct, loud))
+	verify.False(t, verify.Panics(ct, nil))
+	verify.FailedVerifications(ct, "panics", "not panics", "panics")
+
+	// Custom T
+	rec := verify.NewRecorder()
+	verify.False(t, verify.Panics(rec, quiet))
+	verify.False(t, verify.NotPanics(rec, loud))
+	verify.True(t, verify.Panics(rec, loud))
+	verify.True(t, verify.NotPanics(rec, quiet))
+	verify.Length(t, rec.Errors(), 2)
+
+	// Fatal mode stops after reporting outside of the protected frame.
+	rec.Reset()
+	reached := 0
+	completed := rec.Run(func(rt verify.T) {
+		verify.Panics(verify.Require(rt), quiet)
+		reached++
+	})
+	verify.False(t, completed)
+	verify.Equal(t, reached, 0)
+	verify.DeepEqual(t, rec.Records(), []verify.Record{
+		{Method: "Errorf", Message: `fail "panics" verification of 'quiet': got 'no panic', expected 'panic'`},
+		{Method: "FailNow"},
+	})
+
+	// Soft mode continues with a testing.B.
+	testing.Benchmark(func(b *testing.B) {
+		reached = 0
+		if !verify.Panics(verify.Assert(b), quiet) {
+			reached++
+		}
+		if !verify.NotPanics(verify.Assert(b), loud) {
+			reached++
+		}
+	})
+	verify.Equal(t, reached, 2)
+}
+
+// EOF
@@ -0,0 +1,196 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Recording T for testing verification helpers
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// -----------------------------------------------------------------------------
+// Record
+// -----------------------------------------------------------------------------
+
+// Record is one call of a reporting method recorded by a Recorder.
+type Record struct {
+	// Method is the name of the called method, e.g. "Errorf" or "Log".
+	Method string
+
+	// Message is the formatted message of the call. It's empty for
+	// Fail and FailNow.
+	Message string
+}
+
+// IsError returns true if the record reports an error. This are all
+// calls of Error, Errorf, Fatal, and Fatalf.
+func (r Record) IsError() bool {
+	switch r.Method {
+	case "Error", "Errorf", "Fatal", "Fatalf":
+		return true
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Recorder
+// -----------------------------------------------------------------------------
+
+// Recorder is a standalone T recording all calls instead of reporting
+// them. It needs no *testing.T and allows libraries built on verify to
+// test their own verification helpers and inspect exactly what they
+// would report.
+//
+// Like testing.T the methods Fatal, Fatalf, and FailNow stop the calling
+// goroutine. So helpers possibly calling them should be executed using
+// Run.
+type Recorder struct {
+	mu      sync.Mutex
+	records []Record
+	failed  bool
+	helpers int
+}
+
+// Ensure the recorder implements T
+var _ T = (*Recorder)(nil)
+
+// NewRecorder creates a new empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Run executes f with the recorder in an own goroutine and waits until
+// it's done. It returns false if f has been stopped by FailNow, Fatal,
+// Fatalf, or runtime.Goexit. A panic of f is recovered and raised again
+// in the calling goroutine, so it doesn't crash the test binary.
+func (r *Recorder) Run(f func(t T)) bool {
+	return runStoppable(func() { f(r) })
+}
+
+// Errorf records the formatted error message and marks the recorder
+// as failed.
+func (r *Recorder) Errorf(format string, args ...any) {
+	r.record("Errorf", fmt.Sprintf(format, args...), true)
+}
+
+// Error records the error message and marks the recorder as failed.
+func (r *Recorder) Error(args ...any) {
+	r.record("Error", fmt.Sprint(args...), true)
+}
+
+// Fatalf records the formatted error message, marks the recorder
+// as failed, and stops the calling goroutine.
+func (r *Recorder) Fatalf(format string, args ...any) {
+	r.record("Fatalf", fmt.Sprintf(format, args...), true)
+	runtime.Goexit()
+}
+
+// Fatal records the error message, marks the recorder as failed,
+// and stops the calling goroutine.
+func (r *Recorder) Fatal(args ...any) {
+	r.record("Fatal", fmt.Sprint(args...), true)
+	runtime.Goexit()
+}
+
+// Fail marks the recorder as failed.
+func (r *Recorder) Fail() {
+	r.record("Fail", "", true)
+}
+
+// FailNow marks the recorder as failed and stops the calling goroutine.
+func (r *Recorder) FailNow() {
+	r.record("FailNow", "", true)
+	runtime.Goexit()
+}
+
+// Logf records the formatted log message.
+func (r *Recorder) Logf(format string, args ...any) {
+	r.record("Logf", fmt.Sprintf(format, args...), false)
+}
+
+// Log records the log message.
+func (r *Recorder) Log(args ...any) {
+	r.record("Log", fmt.Sprint(args...), false)
+}
+
+// Helper counts the calls marking the caller as helper. The verifications
+// mark themselves on every T providing Helper, so they are counted too.
+func (r *Recorder) Helper() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.helpers++
+}
+
+// Failed returns true if an error has been recorded or Fail or
+// FailNow have been called.
+func (r *Recorder) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+// Records returns all recorded calls in their order.
+func (r *Recorder) Records() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.records)
+}
+
+// Errors returns the messages of all recorded errors.
+func (r *Recorder) Errors() []string {
+	return r.messages(Record.IsError)
+}
+
+// Logs returns the messages of all recorded log calls.
+func (r *Recorder) Logs() []string {
+	return r.messages(func(rec Record) bool {
+		return rec.Method == "Log" || rec.Method == "Logf"
+	})
+}
+
+// HelperCalls returns how often Helper has been called.
+func (r *Recorder) HelperCalls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.helpers
+}
+
+// Reset removes all records and resets the failed state.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = nil
+	r.failed = false
+	r.helpers = 0
+}
+
+// record stores a call.
+func (r *Recorder) record(method, msg string, fails bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, Record{method, msg})
+	r.failed = r.failed || fails
+}
+
+// messages returns the messages of the records selected by
+// the filter.
+func (r *Recorder) messages(filter func(rec Record) bool) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var msgs []string
+	for _, rec := range r.records {
+		if filter(rec) {
+			msgs = append(msgs, rec.Message)
+		}
+	}
+	return msgs
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
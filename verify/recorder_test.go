@@ -0,0 +1,120 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for the recorder
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestRecorder tests the recording of the individual calls.
+func TestRecorder(t *testing.T) {
+	rec := verify.NewRecorder()
+
+	verify.False(t, rec.Failed())
+
+	rec.Helper()
+	rec.Log("hello", " ", "world")
+	rec.Logf("answer is %d", 42)
+	rec.Error("ouch")
+	rec.Errorf("ouch %d", 2)
+
+	verify.True(t, rec.Failed())
+	verify.Equal(t, rec.HelperCalls(), 1)
+	verify.DeepEqual(t, rec.Logs(), []string{"hello world", "answer is 42"})
+	verify.DeepEqual(t, rec.Errors(), []string{"ouch", "ouch 2"})
+	verify.DeepEqual(t, rec.Records(), []verify.Record{
+		{Method: "Log", Message: "hello world"},
+		{Method: "Logf", Message: "answer is 42"},
+		{Method: "Error", Message: "ouch"},
+		{Method: "Errorf", Message: "ouch 2"},
+	})
+
+	rec.Reset()
+
+	verify.False(t, rec.Failed())
+	verify.Length(t, rec.Records(), 0)
+	verify.Equal(t, rec.HelperCalls(), 0)
+
+	rec.Fail()
+
+	verify.True(t, rec.Failed())
+	verify.Length(t, rec.Errors(), 0)
+}
+
+// TestRecorderRun tests the stopping of a function by the fatal methods.
+func TestRecorderRun(t *testing.T) {
+	rec := verify.NewRecorder()
+
+	completed := rec.Run(func(t verify.T) {
+		t.Errorf("first")
+	})
+	verify.True(t, completed)
+
+	reached := false
+	completed = rec.Run(func(t verify.T) {
+		rec.Fatalf("second %s", "fatal")
+		reached = true
+	})
+	verify.False(t, completed)
+	verify.False(t, reached)
+
+	completed = rec.Run(func(t verify.T) {
+		rec.FailNow()
+	})
+	verify.False(t, completed)
+
+	verify.PanicsWithValue(t, func() {
+		rec.Run(func(t verify.T) {
+			panic("third")
+		})
+	}, "third")
+
+	verify.DeepEqual(t, rec.Records(), []verify.Record{
+		{Method: "Errorf", Message: "first"},
+		{Method: "Fatalf", Message: "second fatal"},
+		{Method: "FailNow"},
+	})
+}
+
+// TestRecorderHelper tests a custom verification helper with a recorder.
+func TestRecorderHelper(t *testing.T) {
+	verifyPositive := func(t verify.T, values ...int) bool {
+		for _, v := range values {
+			if !verify.More(t, v, 0, "all values must be positive") {
+				return false
+			}
+		}
+		return true
+	}
+
+	rec := verify.NewRecorder()
+
+	verify.True(t, verifyPositive(rec, 1, 2, 3))
+	verify.False(t, rec.Failed())
+	verify.Equal(t, rec.HelperCalls(), 0)
+
+	verify.False(t, verifyPositive(rec, 1, -2, 3))
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "is more" verification of 'v': got '-2', expected '0' (all values must be positive)`,
+	})
+	verify.More(t, rec.HelperCalls(), 0)
+
+	// Fatal mode passes the marking to the recorder too.
+	rec.Reset()
+	rec.Run(func(rt verify.T) {
+		verify.True(verify.Require(rt), false)
+	})
+	verify.More(t, rec.HelperCalls(), 0)
+}
+
+// EOF
@@ -0,0 +1,202 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Inline snapshots updating the expected literals in the test source
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// -----------------------------------------------------------------------------
+// Verifications
+// -----------------------------------------------------------------------------
+
+const (
+	// SnapshotUpdateEnv is the name of the environment variable switching
+	// Snapshot into the update mode.
+	SnapshotUpdateEnv = "SNAPSHOT_UPDATE"
+
+	// UpdateFlag is the name of the test flag switching Snapshot into the
+	// update mode too, if registered by the tested package. It's shared
+	// with the golden package.
+	UpdateFlag = "update"
+)
+
+// Snapshot checks if the gotten value formatted as text equals the
+// expected snapshot. Strings and byte slices are taken as they are,
+// errors and fmt.Stringer by their text, all other values are formatted
+// with %+v.
+//
+// In update mode differing snapshots don't fail. Instead the expected
+// string literal in the test source is replaced by the gotten text, so
+// the expected value has to be passed as literal directly.
+//
+//	verify.Snapshot(t, render(page), ``)
+//
+// Run the tests once with SNAPSHOT_UPDATE=true to fill the literal and
+// review the changes of the source afterwards.
+func Snapshot(t T, gotten any, expected string, infos ...string) bool {
+	snapshot := fsnapshot(gotten)
+	if snapshot == expected {
+		return true
+	}
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	if !updatingSnapshots() {
+		reportFailure(t, failure{
+			verification: "matches snapshot",
+			expected:     expected,
+			got:          snapshot,
+			diff:         renderDiff(expected, snapshot),
+			details:      fmt.Sprintf("set %s=true to update the snapshot", SnapshotUpdateEnv),
+			infos:        infos,
+		})
+		return false
+	}
+	at, err := updateSnapshot(snapshot)
+	if err != nil {
+		reportFailure(t, failure{
+			verification: "matches snapshot",
+			expected:     expected,
+			got:          snapshot,
+			details:      fmt.Sprintf("cannot update snapshot: %v", err),
+			infos:        infos,
+		})
+		return false
+	}
+	if lt, ok := t.(interface{ Logf(string, ...any) }); ok {
+		lt.Logf("updated snapshot at %s", at)
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Source update
+// -----------------------------------------------------------------------------
+
+// lineShift notes that the lines following line of the original source
+// file moved by delta after a snapshot update.
+type lineShift struct {
+	line  int
+	delta int
+}
+
+// snapshotShifts contains the shifts per updated source file. The lines
+// of the call stack still refer to the compiled original source, so they
+// have to be mapped to the updated file.
+var snapshotShifts = struct {
+	mu     sync.Mutex
+	shifts map[string][]lineShift
+}{
+	shifts: make(map[string][]lineShift),
+}
+
+// updateSnapshot replaces the expected literal of the calling Snapshot
+// with the snapshot and returns the updated location.
+func updateSnapshot(snapshot string) (string, error) {
+	c, ok := locateCaller()
+	if !ok || c.verification == "" {
+		return "", errors.New("cannot locate the caller")
+	}
+	snapshotShifts.mu.Lock()
+	defer snapshotShifts.mu.Unlock()
+	line := c.line
+	for _, shift := range snapshotShifts.shifts[c.file] {
+		if shift.line < c.line {
+			line += shift.delta
+		}
+	}
+	sf := parseSource(c.file)
+	if sf == nil {
+		return "", fmt.Errorf("cannot parse source file %s", c.file)
+	}
+	call := sf.findCall(line, c.verification)
+	if call == nil || len(call.Args) < 3 {
+		return "", fmt.Errorf("cannot find the call of %s in line %d", c.verification, line)
+	}
+	lit, ok := call.Args[2].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("expected value is no string literal")
+	}
+	start := sf.fset.Position(lit.Pos()).Offset
+	end := sf.fset.Position(lit.End()).Offset
+	literal := quoteSnapshot(snapshot, strings.HasPrefix(lit.Value, "`"))
+	src := make([]byte, 0, len(sf.src)-(end-start)+len(literal))
+	src = append(src, sf.src[:start]...)
+	src = append(src, literal...)
+	src = append(src, sf.src[end:]...)
+	info, err := os.Stat(c.file)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(c.file, src, info.Mode().Perm()); err != nil {
+		return "", err
+	}
+	if delta := strings.Count(literal, "\n") - strings.Count(lit.Value, "\n"); delta != 0 {
+		snapshotShifts.shifts[c.file] = append(snapshotShifts.shifts[c.file], lineShift{c.line, delta})
+	}
+	sourceCache.mu.Lock()
+	delete(sourceCache.files, c.file)
+	sourceCache.mu.Unlock()
+	return fmt.Sprintf("%s:%d", c.file, line), nil
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// updatingSnapshots checks if the snapshots shall be updated. It checks
+// the environment variable and an update flag registered by the tested
+// package.
+func updatingSnapshots() bool {
+	if f := flag.Lookup(UpdateFlag); f != nil {
+		if update, err := strconv.ParseBool(f.Value.String()); err == nil && update {
+			return true
+		}
+	}
+	update, _ := strconv.ParseBool(os.Getenv(SnapshotUpdateEnv))
+	return update
+}
+
+// quoteSnapshot returns the snapshot as Go string literal. Multi-line
+// snapshots and those of former raw literals stay readable as raw
+// literals if possible.
+func quoteSnapshot(snapshot string, raw bool) string {
+	rawable := utf8.ValidString(snapshot) && !strings.ContainsAny(snapshot, "`\r\ufeff")
+	if rawable && (raw || strings.Contains(snapshot, "\n")) {
+		return "`" + snapshot + "`"
+	}
+	return strconv.Quote(snapshot)
+}
+
+// fsnapshot formats a value as snapshot text.
+func fsnapshot(v any) string {
+	switch tv := v.(type) {
+	case string:
+		return tv
+	case []byte:
+		return string(tv)
+	case error:
+		return tv.Error()
+	case fmt.Stringer:
+		return tv.String()
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,168 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for inline snapshots
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestSnapshot tests the verification of inline snapshots.
+func TestSnapshot(t *testing.T) {
+	t.Setenv(verify.SnapshotUpdateEnv, "")
+
+	// Positive test cases with regular testing.T
+	verify.Snapshot(t, "hello", "hello")
+	verify.Snapshot(t, []byte("line 1\nline 2"), `line 1
+line 2`)
+	verify.Snapshot(t, errors.New("not found"), "not found")
+	verify.Snapshot(t, struct {
+		Name string
+		Age  int
+	}{"Alice", 42}, "{Name:Alice Age:42}")
+	verify.Snapshot(t, []int{1, 2, 3}, "[1 2 3]")
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Snapshot(ct, "hello", "world")
+	verify.Snapshot(ct, 42, "")
+
+	verify.FailedVerifications(ct, "matches snapshot", "matches snapshot")
+}
+
+// TestSnapshotMessages tests the failure messages of inline snapshots.
+func TestSnapshotMessages(t *testing.T) {
+	t.Setenv(verify.SnapshotUpdateEnv, "")
+
+	rec := verify.NewRecorder()
+	greeting := "hello"
+	lines := "alpha\nbeta\n"
+
+	verify.Snapshot(rec, greeting, "world")
+	verify.Snapshot(rec, lines, "alpha\ngamma\n")
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"matches snapshot\" verification of 'greeting': got 'hello', expected 'world'\n" +
+			"set SNAPSHOT_UPDATE=true to update the snapshot",
+		"fail \"matches snapshot\" verification of 'lines': got and expected differ\n" +
+			"set SNAPSHOT_UPDATE=true to update the snapshot\n" +
+			"--- expected\n" +
+			"+++ got\n" +
+			"@@ -1,3 +1,3 @@\n" +
+			" alpha\n" +
+			"-gamma\n" +
+			"+beta\n" +
+			" ",
+	})
+}
+
+// TestSnapshotUpdate tests the rewriting of the snapshots in the test
+// source by running the tests of a temporary module in update mode.
+func TestSnapshotUpdate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping snapshot update in short mode")
+	}
+	gobin := filepath.Join(runtime.GOROOT(), "bin", "go")
+	if _, err := os.Stat(gobin); err != nil {
+		t.Skip("go command not available")
+	}
+	root, err := filepath.Abs("..")
+	verify.NoError(t, err)
+	gosum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	verify.NoError(t, err)
+
+	dir := t.TempDir()
+	gomod := "module example.com/snapshots\n\ngo 1.24\n\n" +
+		"require tideland.dev/go/asserts v0.0.0\n\n" +
+		"replace tideland.dev/go/asserts => " + root + "\n"
+	verify.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod), 0o644))
+	verify.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), gosum, 0o644))
+	verify.NoError(t, os.WriteFile(filepath.Join(dir, "snapshots_test.go"), []byte(snapshotSource), 0o644))
+
+	run := func(update string) ([]byte, error) {
+		cmd := exec.Command(gobin, "test", "-count=1", "-mod=mod", ".")
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), verify.SnapshotUpdateEnv+"="+update, "GOFLAGS=", "GOPROXY=off", "GOWORK=off")
+		return cmd.CombinedOutput()
+	}
+
+	_, err = run("false")
+	verify.Error(t, err)
+
+	out, err := run("true")
+	if !verify.NoError(t, err) {
+		t.Log(string(out))
+	}
+	updated, err := os.ReadFile(filepath.Join(dir, "snapshots_test.go"))
+	verify.NoError(t, err)
+	verify.Equal(t, string(updated), snapshotUpdated)
+
+	out, err = run("false")
+	if !verify.NoError(t, err) {
+		t.Log(string(out))
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Helper
+// -----------------------------------------------------------------------------
+
+// snapshotSource contains outdated snapshots to update.
+const snapshotSource = `package snapshots
+
+import (
+	"strings"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+func TestSnapshots(t *testing.T) {
+	verify.Snapshot(t, strings.Repeat("x\n", 3), "")
+	verify.Snapshot(t, "quoted \"text\"", "old")
+	verify.Snapshot(t, "unchanged", "unchanged")
+	for _, n := range []int{1, 2} {
+		verify.Snapshot(t, n*0, "")
+	}
+	verify.Snapshot(t, "single", ` + "`a\nb\nc`" + `)
+}
+`
+
+// snapshotUpdated contains the snapshots after the update.
+const snapshotUpdated = `package snapshots
+
+import (
+	"strings"
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+func TestSnapshots(t *testing.T) {
+	verify.Snapshot(t, strings.Repeat("x\n", 3), ` + "`x\nx\nx\n`" + `)
+	verify.Snapshot(t, "quoted \"text\"", "quoted \"text\"")
+	verify.Snapshot(t, "unchanged", "unchanged")
+	for _, n := range []int{1, 2} {
+		verify.Snapshot(t, n*0, "0")
+	}
+	verify.Snapshot(t, "single", ` + "`single`" + `)
+}
+`
+
+// EOF
@@ -0,0 +1,246 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Capturing of the verified source expressions
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// -----------------------------------------------------------------------------
+// Source files
+// -----------------------------------------------------------------------------
+
+// verifyPackage is the full name of this package as used in the
+// function names of the call stack.
+var verifyPackage = reflect.TypeOf(sourceFile{}).PkgPath()
+
+// verifyPackages are the packages whose functions are verifications. Next
+// to this one these are the registered ones.
+var verifyPackages = struct {
+	mu   sync.RWMutex
+	pkgs []string
+}{
+	pkgs: []string{verifyPackage},
+}
+
+// RegisterPackage marks the functions of the calling package as
+// verifications. Packages building own verifications on top of this one,
+// like golden, call it during their initialization. So failures are
+// located at the callers of their exported functions and the expressions
+// passed to them are reported.
+func RegisterPackage() {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+	pkg := funcPackage(runtime.FuncForPC(pc).Name())
+	verifyPackages.mu.Lock()
+	defer verifyPackages.mu.Unlock()
+	if !slices.Contains(verifyPackages.pkgs, pkg) {
+		verifyPackages.pkgs = append(verifyPackages.pkgs, pkg)
+	}
+}
+
+// funcPackage returns the package path of a full function name.
+func funcPackage(function string) string {
+	slash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[slash+1:], "."); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+	return function
+}
+
+// sourceFile is a parsed Go source file.
+type sourceFile struct {
+	fset *token.FileSet
+	file *ast.File
+	src  []byte
+}
+
+// sourceCache contains the parsed source files of the callers. Files
+// which cannot be read or parsed are stored as nil.
+var sourceCache = struct {
+	mu    sync.Mutex
+	files map[string]*sourceFile
+}{
+	files: make(map[string]*sourceFile),
+}
+
+// parseSource returns the parsed source file, either cached or
+// freshly parsed.
+func parseSource(filename string) *sourceFile {
+	sourceCache.mu.Lock()
+	defer sourceCache.mu.Unlock()
+	if sf, ok := sourceCache.files[filename]; ok {
+		return sf
+	}
+	var sf *sourceFile
+	src, err := os.ReadFile(filename)
+	if err == nil {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err == nil {
+			sf = &sourceFile{fset, file, src}
+		}
+	}
+	sourceCache.files[filename] = sf
+	return sf
+}
+
+// -----------------------------------------------------------------------------
+// Expressions
+// -----------------------------------------------------------------------------
+
+// caller describes the location of a verification called by code
+// outside of this package.
+type caller struct {
+	file         string
+	line         int
+	verification string
+}
+
+// locateCaller walks the call stack to the first caller outside of
+// this package. It returns false if there's none.
+func locateCaller() (caller, bool) {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	verification := ""
+	for {
+		frame, more := frames.Next()
+		name, inside := verifyFunction(frame.Function)
+		if !inside {
+			return caller{frame.File, frame.Line, verification}, true
+		}
+		if name != "" {
+			verification = name
+		}
+		if !more {
+			return caller{}, false
+		}
+	}
+}
+
+// expression returns the source of the gotten argument of the
+// verification at the callers location. It's empty if it cannot
+// be found or isn't worth to be reported, like literals.
+func (c caller) expression() string {
+	if c.verification == "" {
+		return ""
+	}
+	return sourceExpression(c.file, c.line, c.verification)
+}
+
+// verifyFunction checks if the function is part of one of the verify
+// packages and returns its name if it's an exported function.
+func verifyFunction(function string) (string, bool) {
+	var name string
+	var ok bool
+	verifyPackages.mu.RLock()
+	for _, pkg := range verifyPackages.pkgs {
+		if name, ok = strings.CutPrefix(function, pkg+"."); ok {
+			break
+		}
+	}
+	verifyPackages.mu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	if i := strings.IndexAny(name, ".["); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" || !unicode.IsUpper([]rune(name)[0]) {
+		return "", true
+	}
+	return name, true
+}
+
+// sourceExpression returns the source of the gotten argument of the
+// named function called at the given file and line.
+func sourceExpression(filename string, line int, name string) string {
+	sf := parseSource(filename)
+	if sf == nil {
+		return ""
+	}
+	call := sf.findCall(line, name)
+	if call == nil || len(call.Args) < 2 {
+		return ""
+	}
+	arg := call.Args[1]
+	if isLiteral(arg) {
+		return ""
+	}
+	expr := string(sf.src[sf.fset.Position(arg.Pos()).Offset:sf.fset.Position(arg.End()).Offset])
+	if strings.Contains(expr, "\n") {
+		expr = strings.Join(strings.Fields(expr), " ")
+	}
+	return expr
+}
+
+// isLiteral checks if the expression is a literal, which would only
+// repeat the gotten value. Negated literals like -1 count as literals too.
+func isLiteral(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit, *ast.FuncLit, *ast.CompositeLit:
+		return true
+	case *ast.UnaryExpr:
+		return isLiteral(e.X)
+	case *ast.ParenExpr:
+		return isLiteral(e.X)
+	case *ast.Ident:
+		return e.Name == "true" || e.Name == "false" || e.Name == "nil"
+	}
+	return false
+}
+
+// findCall returns the innermost call of the named function spanning
+// the given line.
+func (sf *sourceFile) findCall(line int, name string) *ast.CallExpr {
+	var found *ast.CallExpr
+	ast.Inspect(sf.file, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		if sf.fset.Position(n.Pos()).Line > line || sf.fset.Position(n.End()).Line < line {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok && calleeName(call.Fun) == name {
+			found = call
+		}
+		return true
+	})
+	return found
+}
+
+// calleeName returns the name of a called function like verify.True,
+// True, or verify.Equal[int].
+func calleeName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	case *ast.IndexExpr:
+		return calleeName(f.X)
+	case *ast.IndexListExpr:
+		return calleeName(f.X)
+	}
+	return ""
+}
+
+// -----------------------------------------------------------------------------
+// EOF
+// -----------------------------------------------------------------------------
@@ -0,0 +1,51 @@
+// Convenient verification of unit tests in Go libraries and applications.
+//
+// Unit tests for the capturing of source expressions
+//
+// Copyright (C) 2024-2025 Frank Mueller / Oldenburg / Germany / Earth
+
+package verify_test
+
+import (
+	"testing"
+
+	"tideland.dev/go/asserts/verify"
+)
+
+// -----------------------------------------------------------------------------
+// Tests
+// -----------------------------------------------------------------------------
+
+// TestSourceExpression tests the capturing of the verified expressions.
+func TestSourceExpression(t *testing.T) {
+	rec := verify.NewRecorder()
+	users := []string{"alice", "bob"}
+
+	verify.True(rec, len(users) > 2)
+	verify.Equal[int](rec, len(users), 3)
+	verify.DeepEqual(rec, users[1:],
+		[]string{"carol"})
+	verify.True(rec, false)
+	check := func(ok bool) {
+		verify.True(rec, ok)
+	}
+	check(len(users) == 0)
+	verify.Length(rec, []int{1}, 2)
+	verify.Length(rec, map[string]int{"a": 1}, 2)
+	verify.Equal(rec, -1, 1)
+	verify.Equal(rec, -len(users), 1)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		`fail "is true" verification of 'len(users) > 2': got 'false', expected 'true'`,
+		`fail "is equal" verification of 'len(users)': got '2', expected '3'`,
+		`fail "is deeply equal" verification of 'users[1:]' at '[0]': got 'bob', expected 'carol'`,
+		`fail "is true" verification: got 'false', expected 'true'`,
+		`fail "is true" verification of 'ok': got 'false', expected 'true'`,
+		`fail "has length" verification: got '1', expected '2'`,
+		`fail "has length" verification: got '1', expected '2'`,
+		`fail "is equal" verification: got '-1', expected '1'`,
+		`fail "is equal" verification of '-len(users)': got '-2', expected '1'`,
+	})
+}
+
+// EOF
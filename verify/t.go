@@ -8,8 +8,14 @@ package verify
 
 import (
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"text/tabwriter"
 )
 
 // -----------------------------------------------------------------------------
@@ -17,33 +23,131 @@ import (
 // -----------------------------------------------------------------------------
 
 // T replaces testing.T for tests. Missing methods are handled internally.
+// Failures stop a testing.TB but are only reported via Errorf for custom
+// implementations. Require and Assert allow to select the behavior.
 type T interface {
 	Errorf(format string, args ...any)
 }
 
-// continuedTesting is a wrapper around *testing.T that
+// helper is implemented by a T able to mark the calling verifications
+// as helpers, like testing.TB or Recorder.
+type helper interface {
+	Helper()
+}
+
+// Failure describes a failed verification recorded by a continued testing.
+type Failure struct {
+	Verification string
+	Expression   string
+	Expected     any
+	Got          any
+	Infos        []string
+	File         string
+	Line         int
+	Message      string
+}
+
+// continuedTesting is a wrapper around testing.TB that
 // indicates the test should continue running even after
 // a verification failure
 type continuedTesting struct {
-	*testing.T
-	failed int
-	msgs   []string
+	testing.TB
+	scoped   bool
+	mu       sync.Mutex
+	failures []Failure
+	checked  int
 }
 
 // Ensure the wrapper implement T
 var _ T = (*continuedTesting)(nil)
 
+// Errorf implements T. Direct calls are recorded as failures
+// only containing the message.
 func (ct *continuedTesting) Errorf(format string, args ...any) {
 	ct.Helper()
+	ct.record(Failure{Message: fmt.Sprintf(format, args...)})
+}
 
-	ct.failed++
+// FailNow implements testing.TB. A continued testing scoped to a block
+// of FailuresIn only stops the block, all others stop the test.
+func (ct *continuedTesting) FailNow() {
+	if ct.scoped {
+		runtime.Goexit()
+	}
+	ct.TB.FailNow()
+}
 
-	ct.msgs = append(ct.msgs, fmt.Sprintf(format, args...))
+// Fatalf implements testing.TB. A scoped continued testing records the
+// message as failure before stopping the block.
+func (ct *continuedTesting) Fatalf(format string, args ...any) {
+	ct.Helper()
+	if ct.scoped {
+		ct.Errorf(format, args...)
+		runtime.Goexit()
+	}
+	ct.TB.Fatalf(format, args...)
+}
 
-	for _, msg := range ct.msgs {
-		ct.T.Log(msg)
+// Fatal implements testing.TB like Fatalf.
+func (ct *continuedTesting) Fatal(args ...any) {
+	ct.Helper()
+	if ct.scoped {
+		ct.record(Failure{Message: fmt.Sprint(args...)})
+		runtime.Goexit()
 	}
-	ct.msgs = nil
+	ct.TB.Fatal(args...)
+}
+
+// record stores the failure and logs its message.
+func (ct *continuedTesting) record(f Failure) {
+	ct.Helper()
+	ct.mu.Lock()
+	ct.failures = append(ct.failures, f)
+	ct.mu.Unlock()
+	ct.TB.Log(f.Message)
+}
+
+// recorded returns a copy of the recorded failures.
+func (ct *continuedTesting) recorded() []Failure {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return slices.Clone(ct.failures)
+}
+
+// unchecked returns the failures recorded since the last expectation
+// and marks them as checked.
+func (ct *continuedTesting) unchecked() []Failure {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	failures := slices.Clone(ct.failures[ct.checked:])
+	ct.checked = len(ct.failures)
+	return failures
+}
+
+// summary logs a table of all recorded failures.
+func (ct *continuedTesting) summary() {
+	failures := ct.recorded()
+	if len(failures) == 0 {
+		return
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "continued testing recorded %d failure(s):\n", len(failures))
+	tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "#\tlocation\tverification\texpression\tgot\texpected")
+	for i, f := range failures {
+		location := "-"
+		if f.File != "" {
+			location = fmt.Sprintf("%s:%d", filepath.Base(f.File), f.Line)
+		}
+		if f.Verification == "" {
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", i+1, location, ftable(f.Message))
+			continue
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", i+1, location, f.Verification,
+			ftable(f.Expression), ftable(fmt.Sprint(f.Got)), ftable(fmt.Sprint(f.Expected)))
+	}
+	tw.Flush()
+	ct.TB.Log(strings.TrimSuffix(sb.String(), "\n"))
 }
 
 // -----------------------------------------------------------------------------
@@ -51,33 +155,185 @@ func (ct *continuedTesting) Errorf(format string, args ...any) {
 // -----------------------------------------------------------------------------
 
 // ContinuedTesting creates a new T instance that continues after
-// testing failures.
-func ContinuedTesting(t *testing.T) T {
-	ct := &continuedTesting{t, 0, nil}
+// testing failures. It wraps any testing.TB like *testing.T,
+// *testing.B, or *testing.F. The failures are recorded and a
+// summary of them is logged when the test ends.
+func ContinuedTesting(t testing.TB) T {
+	ct := &continuedTesting{TB: t}
+	t.Cleanup(ct.summary)
 	return ct
 }
 
 // IsContinued checks if a testing.T is a continueTesting type.
 func IsContinued(t T) bool {
-	_, ok := t.(*continuedTesting)
+	_, ok := continued(t)
 	return ok
 }
 
+// Require returns a T for which every failed verification stops the
+// test immediately, even if t is a continued testing. Custom T
+// implementations are stopped by their FailNow method if they provide
+// one, otherwise by runtime.Goexit. This allows to mix fatal
+// preconditions with non-fatal checks in one test.
+//
+//	verify.NoError(verify.Require(t), err)
+//	verify.Equal(verify.Assert(t), cfg.Name, "test")
+func Require(t T) T {
+	return withMode(t, fatalMode)
+}
+
+// Assert returns a T for which failed verifications are reported but
+// the test continues. For a testing.TB the test is marked as failed.
+func Assert(t T) T {
+	return withMode(t, softMode)
+}
+
+// Failures returns the failures recorded by a continued testing in the
+// order of their occurrence. For any other T it returns nil.
+func Failures(t T) []Failure {
+	ct, ok := continued(t)
+	if !ok {
+		return nil
+	}
+	return ct.recorded()
+}
+
 // FailureCount validates how many tests failed during continued
 // test to verify the expected number.
 func FailureCount(t T, expected int) bool {
 	var ct *continuedTesting
 	var ok bool
 
-	if ct, ok = t.(*continuedTesting); !ok {
+	if ct, ok = continued(t); !ok {
 		t.Errorf("t is no continued testing")
 		return false
 	}
 
-	if ct.failed != expected {
-		failed := ct.failed
+	if failed := len(ct.recorded()); failed != expected {
 		verificationFailure(t, "failure count", expected, failed)
-		ct.T.Fail()
+		ct.TB.Fail()
+	}
+	return true
+}
+
+// FailedVerifications validates that exactly the expected verifications
+// failed during continued test, in the same order. The verifications are
+// named like in the failure messages, e.g. "is equal" or "has length".
+func FailedVerifications(t T, expected ...string) bool {
+	var ct *continuedTesting
+	var ok bool
+
+	if ct, ok = continued(t); !ok {
+		t.Errorf("t is no continued testing")
+		return false
+	}
+
+	failures := ct.recorded()
+	failed := make([]string, len(failures))
+	for i, f := range failures {
+		failed[i] = f.Verification
+	}
+	if !slices.Equal(failed, expected) {
+		verificationFailure(t, "failed verifications", fnames(expected), fnames(failed))
+		ct.TB.Fail()
+		return false
+	}
+	return true
+}
+
+// FailureMatches validates that exactly one failure occurred since the
+// last expectation, typically by the verification right before, and
+// that its message matches the regular expression.
+//
+//	verify.Equal(ct, len(users), 2)
+//	verify.FailureMatches(ct, `got '1', expected '2'`)
+func FailureMatches(t T, pattern string) bool {
+	var ct *continuedTesting
+	var ok bool
+
+	if ct, ok = continued(t); !ok {
+		t.Errorf("t is no continued testing")
+		return false
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		expectationFailure(ct, "failure matches", pattern, err.Error())
+		return false
+	}
+	failures := ct.unchecked()
+	if len(failures) != 1 {
+		expectationFailure(ct, "failure matches", "1 failure", fmt.Sprintf("%d failures", len(failures)))
+		return false
+	}
+	if !re.MatchString(failures[0].Message) {
+		expectationFailure(ct, "failure matches", pattern, failures[0].Message)
+		return false
+	}
+	return true
+}
+
+// NoFurtherFailures validates that no failure occurred since the
+// last expectation.
+func NoFurtherFailures(t T) bool {
+	var ct *continuedTesting
+	var ok bool
+
+	if ct, ok = continued(t); !ok {
+		t.Errorf("t is no continued testing")
+		return false
+	}
+
+	if failures := ct.unchecked(); len(failures) > 0 {
+		msgs := make([]string, len(failures))
+		for i, f := range failures {
+			msgs[i] = f.Message
+		}
+		expectationFailure(ct, "no further failures", "no failure", strings.Join(msgs, "; "))
+		return false
+	}
+	return true
+}
+
+// FailuresIn runs the block with an own continued testing and validates
+// that exactly the expected verifications failed inside of it, in the
+// same order. A mismatch is reported to t. The block runs in an own
+// goroutine, so fatal failures, e.g. with Require, only stop the block.
+//
+//	verify.FailuresIn(t, func(ct verify.T) {
+//	    verify.Equal(ct, 1, 2)
+//	    verify.True(ct, true)
+//	    verify.Length(ct, "abc", 2)
+//	}, "is equal", "has length")
+func FailuresIn(t T, block func(ct T), expected ...string) bool {
+	var tb testing.TB
+
+	switch typed := t.(type) {
+	case *continuedTesting:
+		tb = typed.TB
+	case testing.TB:
+		tb = typed
+	default:
+		t.Errorf("t is no testing")
+		return false
+	}
+
+	bct := &continuedTesting{TB: tb, scoped: true}
+	runStoppable(func() { block(bct) })
+
+	failures := bct.recorded()
+	failed := make([]string, len(failures))
+	for i, f := range failures {
+		failed[i] = f.Verification
+	}
+	if !slices.Equal(failed, expected) {
+		tb.Helper()
+		if ct, ok := t.(*continuedTesting); ok {
+			expectationFailure(ct, "failures in", fnames(expected), fnames(failed))
+			return false
+		}
+		verificationFailure(t, "failures in", fnames(expected), fnames(failed))
+		return false
 	}
 	return true
 }
@@ -86,25 +342,231 @@ func FailureCount(t T, expected int) bool {
 // UTILS
 // -----------------------------------------------------------------------------
 
-// verificationFailure raises an error containing the failure message.
-func verificationFailure(t T, verification string, expected, got any, infos ...string) {
-	info := strings.Join(infos, ",")
-	msg := fmt.Sprintf("fail %q verification: got '%v', expected '%v'", verification, got, expected)
+// failureMode defines how a failed verification is handled.
+type failureMode int
+
+const (
+	defaultMode failureMode = iota
+	softMode
+	fatalMode
+)
+
+// modedT wraps a custom T to select the failure mode.
+type modedT struct {
+	T
+	mode failureMode
+}
+
+// Helper passes the marking to the wrapped T if it supports it.
+func (mt *modedT) Helper() {
+	if ht, ok := mt.T.(helper); ok {
+		ht.Helper()
+	}
+}
+
+// modedTB wraps a testing.TB, including continued testing, to
+// select the failure mode.
+type modedTB struct {
+	testing.TB
+	mode failureMode
+}
+
+// withMode wraps t to handle failures in the given mode. An
+// existing mode is replaced.
+func withMode(t T, mode failureMode) T {
+	t, _ = unwrapMode(t)
+	if tb, ok := t.(testing.TB); ok {
+		return &modedTB{tb, mode}
+	}
+	return &modedT{t, mode}
+}
+
+// unwrapMode returns the wrapped T and its failure mode.
+func unwrapMode(t T) (T, failureMode) {
+	switch mt := t.(type) {
+	case *modedT:
+		return mt.T, mt.mode
+	case *modedTB:
+		return mt.TB, mt.mode
+	}
+	return t, defaultMode
+}
+
+// continued returns the continued testing t possibly is wrapping.
+func continued(t T) (*continuedTesting, bool) {
+	t, _ = unwrapMode(t)
+	ct, ok := t.(*continuedTesting)
+	return ct, ok
+}
+
+// failure describes a failed verification.
+type failure struct {
+	verification string
+	expr         string
+	file         string
+	line         int
+	path         string
+	expected     any
+	got          any
+	diff         string
+	details      string
+	infos        []string
+}
+
+// message renders the failure as human readable text.
+func (f failure) message() string {
+	of := ""
+	if f.expr != "" {
+		of = fmt.Sprintf(" of '%s'", f.expr)
+	}
+	at := ""
+	if f.path != "" {
+		at = fmt.Sprintf(" at '%s'", f.path)
+	}
+	msg := fmt.Sprintf("fail %q verification%s%s: got '%v', expected '%v'", f.verification, of, at, fseq(f.got), fseq(f.expected))
+	if f.diff != "" {
+		msg = fmt.Sprintf("fail %q verification%s: got and expected differ", f.verification, of)
+	}
+	info := strings.Join(f.infos, ",")
 	if len(info) > 0 {
 		msg = msg + " (" + info + ")"
 	}
-	if tt, ok := t.(*testing.T); ok {
-		tt.Helper()
-		tt.Errorf("%s", msg)
-		tt.FailNow()
-		return
+	if f.details != "" {
+		msg = msg + "\n" + f.details
+	}
+	if f.diff != "" {
+		msg = msg + "\n" + f.diff
+	}
+	return msg
+}
+
+// expectationFailure reports an unmet expectation of a continued
+// testing. It fails the test and marks the own failure as checked.
+func expectationFailure(ct *continuedTesting, expectation string, expected, got any) {
+	ct.Helper()
+	verificationFailure(ct, expectation, expected, got)
+	ct.TB.Fail()
+	ct.unchecked()
+}
+
+// verificationFailure raises an error containing the failure message.
+func verificationFailure(t T, verification string, expected, got any, infos ...string) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	reportFailure(t, failure{
+		verification: verification,
+		expected:     expected,
+		got:          got,
+		infos:        infos,
+	})
+}
+
+// equalityFailure raises an error for failed comparisons. Large or
+// multi-line values are reported as a readable difference.
+func equalityFailure(t T, verification string, expected, got any, infos ...string) {
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
 	}
+	reportFailure(t, failure{
+		verification: verification,
+		expected:     expected,
+		got:          got,
+		diff:         renderDiff(expected, got),
+		infos:        infos,
+	})
+}
+
+// reportFailure passes the failure to the T depending on its type and
+// failure mode. The source expression of the verified value is added
+// if it can be found.
+func reportFailure(t T, f failure) {
+	if c, ok := locateCaller(); ok {
+		f.file, f.line = c.file, c.line
+		if f.expr == "" {
+			f.expr = c.expression()
+		}
+	}
+	msg := f.message()
+	t, mode := unwrapMode(t)
 	if ct, ok := t.(*continuedTesting); ok {
 		ct.Helper()
-		ct.Errorf("%s", msg)
+		ct.record(Failure{
+			Verification: f.verification,
+			Expression:   f.expr,
+			Expected:     f.expected,
+			Got:          f.got,
+			Infos:        f.infos,
+			File:         f.file,
+			Line:         f.line,
+			Message:      msg,
+		})
+		if mode == fatalMode {
+			ct.FailNow()
+		}
+		return
+	}
+	if tb, ok := t.(testing.TB); ok {
+		tb.Helper()
+		tb.Errorf("%s", msg)
+		if mode != softMode {
+			tb.FailNow()
+		}
 		return
 	}
 	t.Errorf("%s", msg)
+	if mode == fatalMode {
+		if fn, ok := t.(interface{ FailNow() }); ok {
+			fn.FailNow()
+		}
+		runtime.Goexit()
+	}
+}
+
+// runStoppable executes f in an own goroutine and waits until it's done.
+// It returns false if f has been stopped by runtime.Goexit, e.g. via
+// FailNow. A panic of f is recovered and raised again in the calling
+// goroutine, so it doesn't crash the test binary.
+func runStoppable(f func()) bool {
+	type outcome struct {
+		completed bool
+		panicked  bool
+		value     any
+	}
+	done := make(chan outcome)
+	go func() {
+		var o outcome
+		defer func() {
+			if v := recover(); v != nil {
+				o.panicked, o.value = true, v
+			}
+			done <- o
+		}()
+		f()
+		o.completed = true
+	}()
+	o := <-done
+	if o.panicked {
+		panic(o.value)
+	}
+	return o.completed
+}
+
+// ftable formats a value as single line for the summary table.
+func ftable(s string) string {
+	if s == "" {
+		return "-"
+	}
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > 40 {
+		s = string(r[:37]) + "..."
+	}
+	return s
+}
+
+// fnames formats a list of verification names.
+func fnames(names []string) string {
+	return "[" + strings.Join(names, ", ") + "]"
 }
 
 // -----------------------------------------------------------------------------
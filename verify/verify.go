@@ -7,13 +7,13 @@
 package verify
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
 	"slices"
 	"strings"
-	"testing"
 	"time"
 
 	"golang.org/x/exp/constraints"
@@ -26,7 +26,7 @@ import (
 // True checks if the given value is true.
 func True(t T, gotten bool, infos ...string) bool {
 	if !gotten {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is true", true, gotten, infos...)
@@ -38,7 +38,7 @@ func True(t T, gotten bool, infos ...string) bool {
 // False checks if the given value is false. It's the opposite of True.
 func False(t T, gotten bool, infos ...string) bool {
 	if gotten {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is false", false, gotten)
@@ -50,7 +50,7 @@ func False(t T, gotten bool, infos ...string) bool {
 // Nil checks if the given value is nil.
 func Nil(t T, gotten any, infos ...string) bool {
 	if gotten != nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is nil", nil, gotten, infos...)
@@ -62,7 +62,7 @@ func Nil(t T, gotten any, infos ...string) bool {
 // NotNil checks if the given value is not nil. It's the opposite of Nil.
 func NotNil(t T, gotten any, infos ...string) bool {
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is not nil", nil, gotten, infos...)
@@ -73,12 +73,13 @@ func NotNil(t T, gotten any, infos ...string) bool {
 
 // Equal checks if the gotten and expected values are equal.
 // It uses the == operator for comparable types and supports time.Duration.
+// Long or multi-line values are reported as a difference.
 func Equal[C comparable](t T, gotten, expected C, infos ...string) bool {
 	if expected != gotten {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
-		verificationFailure(t, "is equal", expected, gotten, infos...)
+		equalityFailure(t, "is equal", expected, gotten, infos...)
 		return false
 	}
 	return true
@@ -88,7 +89,7 @@ func Equal[C comparable](t T, gotten, expected C, infos ...string) bool {
 // It uses the != operator for comparable types and supports time.Duration.
 func Different[C comparable](t T, gotten, expected C, infos ...string) bool {
 	if expected == gotten {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is different", expected, gotten, infos...)
@@ -98,10 +99,12 @@ func Different[C comparable](t T, gotten, expected C, infos ...string) bool {
 }
 
 // Length checks if the given value has the expected length. This only
-// works for the according types for len(). All others fail.
+// works for the according types for len() as well as iter.Seq and
+// iter.Seq2. All others fail. Sequences are consumed up to the limit
+// set with SetSeqLimit.
 func Length(t T, gotten any, expected int, infos ...string) bool {
 	if expected < 0 {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "has length", expected, "not quantifiable", infos...)
@@ -109,14 +112,18 @@ func Length(t T, gotten any, expected int, infos ...string) bool {
 	}
 	gottenLen := flexlen(gotten)
 	if gottenLen < 0 {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
+		if isSeq(reflect.ValueOf(gotten)) {
+			verificationFailure(t, "has length", expected, fexceeded(), infos...)
+			return false
+		}
 		verificationFailure(t, "has length", expected, "gotten not quantifiable", infos...)
 		return false
 	}
 	if gottenLen != expected {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "has length", expected, gottenLen, infos...)
@@ -125,19 +132,30 @@ func Length(t T, gotten any, expected int, infos ...string) bool {
 	return true
 }
 
-// Empty checks if the given value is empty. This only works for the according types for len().
-// All others fail.
+// Empty checks if the given value is empty. This only works for the
+// according types for len() as well as iter.Seq and iter.Seq2. All
+// others fail.
 func Empty(t T, gotten any, infos ...string) bool {
+	if empty, ok := seqEmpty(gotten); ok {
+		if !empty {
+			if ht, ok := t.(helper); ok {
+				ht.Helper()
+			}
+			verificationFailure(t, "empty", 0, "non-empty sequence", infos...)
+			return false
+		}
+		return true
+	}
 	gottenLen := flexlen(gotten)
 	if gottenLen < 0 {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "empty", 0, "gotten not quantifiable", infos...)
 		return false
 	}
 	if gottenLen != 0 {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "empty", 0, gottenLen, infos...)
@@ -146,19 +164,30 @@ func Empty(t T, gotten any, infos ...string) bool {
 	return true
 }
 
-// NotEmpty checks if the given value is not empty. This only works for the according types for len().
-// All others fail.
+// NotEmpty checks if the given value is not empty. This only works for the
+// according types for len() as well as iter.Seq and iter.Seq2. All
+// others fail.
 func NotEmpty(t T, gotten any, infos ...string) bool {
+	if empty, ok := seqEmpty(gotten); ok {
+		if empty {
+			if ht, ok := t.(helper); ok {
+				ht.Helper()
+			}
+			verificationFailure(t, "not empty", "> 0", 0, infos...)
+			return false
+		}
+		return true
+	}
 	gottenLen := flexlen(gotten)
 	if gottenLen < 0 {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "not empty", 0, "gotten not quantifiable", infos...)
 		return false
 	}
 	if gottenLen == 0 {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "not empty", "> 0", gottenLen, infos...)
@@ -168,10 +197,12 @@ func NotEmpty(t T, gotten any, infos ...string) bool {
 }
 
 // Less checks if the gotten value is less than the expected one.
-// Supports integers, floats, and time.Duration.
-func Less[C constraints.Integer | constraints.Float](t T, gotten, expected C, infos ...string) bool {
-	if gotten >= expected {
-		if ht, ok := t.(testing.TB); ok {
+// Supports all ordered types like integers, floats, strings, and
+// time.Duration. Other types can be checked with LessFunc. A NaN is
+// never less.
+func Less[C cmp.Ordered](t T, gotten, expected C, infos ...string) bool {
+	if !(gotten < expected) {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is less", expected, gotten, infos...)
@@ -181,10 +212,40 @@ func Less[C constraints.Integer | constraints.Float](t T, gotten, expected C, in
 }
 
 // More checks if the gotten value is more than the expected one.
-// Supports integers, floats, and time.Duration.
-func More[C constraints.Integer | constraints.Float](t T, gotten, expected C, infos ...string) bool {
-	if gotten <= expected {
-		if ht, ok := t.(testing.TB); ok {
+// Supports all ordered types like integers, floats, strings, and
+// time.Duration. Other types can be checked with MoreFunc. A NaN is
+// never more.
+func More[C cmp.Ordered](t T, gotten, expected C, infos ...string) bool {
+	if !(gotten > expected) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is more", expected, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// LessFunc checks if the gotten value is less than the expected one
+// according to the comparator, which returns a negative number if a < b,
+// a positive one if a > b, and zero if both are equal. So methods like
+// time.Time.Compare or (*big.Int).Cmp can be used.
+func LessFunc[C any](t T, gotten, expected C, compare func(a, b C) int, infos ...string) bool {
+	if compare(gotten, expected) >= 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		verificationFailure(t, "is less", expected, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// MoreFunc checks if the gotten value is more than the expected one
+// according to the comparator. See LessFunc.
+func MoreFunc[C any](t T, gotten, expected C, compare func(a, b C) int, infos ...string) bool {
+	if compare(gotten, expected) <= 0 {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is more", expected, gotten, infos...)
@@ -194,10 +255,12 @@ func More[C constraints.Integer | constraints.Float](t T, gotten, expected C, in
 }
 
 // About checks if the gotten values equal within a expected delta. Possible
-// values are integers, floats, and time.Duration.
+// values are integers, floats, and time.Duration. NaN never is about equal.
+// See AboutRelative and AboutULP for further float comparisons.
 func About[C constraints.Integer | constraints.Float](t T, gotten, expected, tolerance C, infos ...string) bool {
-	if gotten < expected-tolerance || gotten > expected+tolerance {
-		if ht, ok := t.(testing.TB); ok {
+	// Negated check so that NaN fails.
+	if !(gotten >= expected-tolerance && gotten <= expected+tolerance) {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		expectedDescr := fmt.Sprintf("%v' +/- '%v'", expected, tolerance)
@@ -210,7 +273,7 @@ func About[C constraints.Integer | constraints.Float](t T, gotten, expected, tol
 // Substring checks if the gotten string is a substring of the expected string.
 func Substring(t T, gotten, expected string, infos ...string) bool {
 	if !strings.Contains(expected, gotten) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "substring", expected, gotten, infos...)
@@ -222,7 +285,7 @@ func Substring(t T, gotten, expected string, infos ...string) bool {
 // Contains checks if the slice contains the expected element.
 func Contains[S ~[]E, E comparable](t T, gotten E, expected S, infos ...string) bool {
 	if !slices.Contains(expected, gotten) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "contains", expected, gotten, infos...)
@@ -235,14 +298,14 @@ func Contains[S ~[]E, E comparable](t T, gotten E, expected S, infos ...string)
 func Match(t T, gotten, expected string, infos ...string) bool {
 	re, err := regexp.Compile(expected)
 	if err != nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "matches", expected, err.Error(), infos...)
 		return false
 	}
 	if !re.MatchString(gotten) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "matches", expected, gotten, infos...)
@@ -254,7 +317,7 @@ func Match(t T, gotten, expected string, infos ...string) bool {
 // Simultaneous checks if the gotten time is simultaneous with the expected time.
 func Simultaneous(t T, gotten, expected time.Time, infos ...string) bool {
 	if !gotten.Equal(expected) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is time simultaneous", ftim(expected), ftim(gotten), infos...)
@@ -266,7 +329,7 @@ func Simultaneous(t T, gotten, expected time.Time, infos ...string) bool {
 // Before checks if the gotten time is before the expected time.
 func Before(t T, gotten, expected time.Time, infos ...string) bool {
 	if !gotten.Before(expected) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is time before", ftim(expected), ftim(gotten), infos...)
@@ -278,7 +341,7 @@ func Before(t T, gotten, expected time.Time, infos ...string) bool {
 // After checks if the gotten time is after the expected time.
 func After(t T, gotten, expected time.Time, infos ...string) bool {
 	if !gotten.After(expected) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is time after", ftim(expected), ftim(gotten), infos...)
@@ -297,7 +360,7 @@ func Between(t T, gotten, expectedBegin, expectedEnd time.Time, infos ...string)
 		expstr = fmt.Sprintf("'%s' and '%s'", ftim(expectedBegin), ftim(expectedEnd))
 	}
 	if expstr != "" {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is between", expstr, ftim(gotten), infos...)
@@ -309,7 +372,7 @@ func Between(t T, gotten, expectedBegin, expectedEnd time.Time, infos ...string)
 // Shorter checks if the gotten duration is shorter than the expected duration.
 func Shorter(t T, gotten, expected time.Duration, infos ...string) bool {
 	if gotten > expected {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "duration is shorter", expected, gotten, infos...)
@@ -321,7 +384,7 @@ func Shorter(t T, gotten, expected time.Duration, infos ...string) bool {
 // Longer checks if the gotten duration is longer than the expected duration.
 func Longer(t T, gotten, expected time.Duration, infos ...string) bool {
 	if gotten < expected {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "duration is longer", expected, gotten, infos...)
@@ -330,14 +393,16 @@ func Longer(t T, gotten, expected time.Duration, infos ...string) bool {
 	return true
 }
 
-// InRange checks if the given value is within lower and upper bounds. Possible
-// values are integers, floats, and time.Duration.
-func InRange[C constraints.Integer | constraints.Float](t T, gotten, expectedLower, expectedUpper C, infos ...string) bool {
+// InRange checks if the given value is within lower and upper bounds.
+// Possible values are all ordered types like integers, floats, strings,
+// and time.Duration. Other types can be checked with InRangeFunc. A NaN
+// is never in range.
+func InRange[C cmp.Ordered](t T, gotten, expectedLower, expectedUpper C, infos ...string) bool {
 	if expectedLower > expectedUpper {
 		expectedLower, expectedUpper = expectedUpper, expectedLower
 	}
-	if gotten < expectedLower || gotten > expectedUpper {
-		if ht, ok := t.(testing.TB); ok {
+	if !(gotten >= expectedLower && gotten <= expectedUpper) {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		expectedDescr := fmt.Sprintf("'%v' to '%v'", expectedLower, expectedUpper)
@@ -348,13 +413,47 @@ func InRange[C constraints.Integer | constraints.Float](t T, gotten, expectedLow
 }
 
 // OutOfRange checks if the given value is outside lower and upper bounds. It's the
-// opposite of InRange.
-func OutOfRange[C constraints.Integer | constraints.Float](t T, gotten, expectedLower, expectedUpper C, infos ...string) bool {
+// opposite of InRange. A NaN is never out of range either.
+func OutOfRange[C cmp.Ordered](t T, gotten, expectedLower, expectedUpper C, infos ...string) bool {
 	if expectedLower > expectedUpper {
 		expectedLower, expectedUpper = expectedUpper, expectedLower
 	}
-	if gotten >= expectedLower && gotten <= expectedUpper {
-		if ht, ok := t.(testing.TB); ok {
+	if !(gotten < expectedLower || gotten > expectedUpper) {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		expectedDescr := fmt.Sprintf("'%v' to '%v'", expectedLower, expectedUpper)
+		verificationFailure(t, "is out of range", expectedDescr, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// InRangeFunc checks if the given value is within lower and upper bounds
+// according to the comparator. See LessFunc.
+func InRangeFunc[C any](t T, gotten, expectedLower, expectedUpper C, compare func(a, b C) int, infos ...string) bool {
+	if compare(expectedLower, expectedUpper) > 0 {
+		expectedLower, expectedUpper = expectedUpper, expectedLower
+	}
+	if compare(gotten, expectedLower) < 0 || compare(gotten, expectedUpper) > 0 {
+		if ht, ok := t.(helper); ok {
+			ht.Helper()
+		}
+		expectedDescr := fmt.Sprintf("'%v' to '%v'", expectedLower, expectedUpper)
+		verificationFailure(t, "is in range", expectedDescr, gotten, infos...)
+		return false
+	}
+	return true
+}
+
+// OutOfRangeFunc checks if the given value is outside lower and upper bounds
+// according to the comparator. It's the opposite of InRangeFunc.
+func OutOfRangeFunc[C any](t T, gotten, expectedLower, expectedUpper C, compare func(a, b C) int, infos ...string) bool {
+	if compare(expectedLower, expectedUpper) > 0 {
+		expectedLower, expectedUpper = expectedUpper, expectedLower
+	}
+	if compare(gotten, expectedLower) >= 0 && compare(gotten, expectedUpper) <= 0 {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		expectedDescr := fmt.Sprintf("'%v' to '%v'", expectedLower, expectedUpper)
@@ -367,7 +466,7 @@ func OutOfRange[C constraints.Integer | constraints.Float](t T, gotten, expected
 // Error checks if the given error is not nil.
 func Error(t T, err error) bool {
 	if err == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is error", "error", nil)
@@ -380,7 +479,7 @@ func Error(t T, err error) bool {
 // It's the opposite of Error.
 func NoError(t T, gotten error) bool {
 	if gotten != nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is no error", nil, gotten)
@@ -393,10 +492,15 @@ func NoError(t T, gotten error) bool {
 // It uses the errors.Is() function.
 func IsError(t T, gotten, expected error) bool {
 	if !errors.Is(gotten, expected) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
-		verificationFailure(t, "is expected error", expected, gotten)
+		reportFailure(t, failure{
+			verification: "is expected error",
+			expected:     expected,
+			got:          ferror(gotten),
+			details:      ferrtreeIfNested(gotten),
+		})
 		return false
 	}
 	return true
@@ -407,55 +511,80 @@ func IsError(t T, gotten, expected error) bool {
 // to the error type you want to check for.
 func AsError(t T, gotten error, expected any) bool {
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
-		verificationFailure(t, "error as type", expected, gotten)
+		verificationFailure(t, "error as type", ftarget(expected), gotten)
 		return false
 	}
 	if !errors.As(gotten, expected) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
-		verificationFailure(t, "error as type", expected, gotten)
+		reportFailure(t, failure{
+			verification: "error as type",
+			expected:     ftarget(expected),
+			got:          ferror(gotten),
+			details:      ferrtreeIfNested(gotten),
+		})
 		return false
 	}
 	return true
 }
 
 // UnwrapError checks if the given error unwraps to the expected error.
-// It uses the errors.Unwrap() function.
+// It supports Unwrap() error as well as Unwrap() []error of joined
+// errors, where one of the unwrapped errors has to match.
 func UnwrapError(t T, gotten, expected error) bool {
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "error unwraps to", expected, gotten)
 		return false
 	}
-	unwrapped := errors.Unwrap(gotten)
-	if !errors.Is(unwrapped, expected) {
-		if ht, ok := t.(testing.TB); ok {
-			ht.Helper()
+	unwrapped := unwrapAll(gotten)
+	if len(unwrapped) == 0 && expected == nil {
+		return true
+	}
+	for _, err := range unwrapped {
+		if errors.Is(err, expected) {
+			return true
 		}
-		verificationFailure(t, "error unwraps to", expected, unwrapped)
-		return false
 	}
-	return true
+	if ht, ok := t.(helper); ok {
+		ht.Helper()
+	}
+	var got any
+	switch len(unwrapped) {
+	case 0:
+		got = nil
+	case 1:
+		got = unwrapped[0]
+	default:
+		got = ferrors(unwrapped)
+	}
+	reportFailure(t, failure{
+		verification: "error unwraps to",
+		expected:     expected,
+		got:          got,
+		details:      ferrtreeIfNested(gotten),
+	})
+	return false
 }
 
 // ErrorContains check if the given error is not nil and its message
 // contains an expected string.
 func ErrorContains(t T, gotten error, expected string) bool {
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "error contains", expected, gotten)
 		return false
 	}
 	if !strings.Contains(gotten.Error(), expected) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "error contains", expected, gotten.Error())
@@ -468,7 +597,7 @@ func ErrorContains(t T, gotten error, expected string) bool {
 // matches the expected regular expression.
 func ErrorMatch(t T, gotten error, expected string) bool {
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "error does match", expected, gotten)
@@ -476,7 +605,7 @@ func ErrorMatch(t T, gotten error, expected string) bool {
 	}
 	re := regexp.MustCompile(expected)
 	if !re.MatchString(gotten.Error()) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "error does match", expected, gotten.Error())
@@ -490,7 +619,7 @@ func ErrorMatch(t T, gotten error, expected string) bool {
 // var stringer fmt.Stinger and then verify.Implements(t, myVar, &fmtStringer).
 func Implements(t T, gotten, expected any) bool {
 	if expected == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "does implement", "expected instance", nil)
@@ -498,7 +627,7 @@ func Implements(t T, gotten, expected any) bool {
 	}
 
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "does implement", "actual instance", nil)
@@ -507,7 +636,7 @@ func Implements(t T, gotten, expected any) bool {
 
 	expectedType := reflect.TypeOf(expected).Elem()
 	if expectedType.Kind() != reflect.Interface {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "does implement", "expected interface", nil)
@@ -516,7 +645,7 @@ func Implements(t T, gotten, expected any) bool {
 
 	actualType := reflect.TypeOf(gotten)
 	if !actualType.Implements(expectedType) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "does implement", expectedType, actualType)
@@ -529,7 +658,7 @@ func Implements(t T, gotten, expected any) bool {
 // expected type.
 func Assignability(t T, gotten, expected any) bool {
 	if expected == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is assignable to", "expected type", nil)
@@ -537,7 +666,7 @@ func Assignability(t T, gotten, expected any) bool {
 	}
 
 	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is assignable to", "actual type", nil)
@@ -548,7 +677,7 @@ func Assignability(t T, gotten, expected any) bool {
 	actualType := reflect.TypeOf(gotten)
 
 	if !actualType.AssignableTo(expectedType) {
-		if ht, ok := t.(testing.TB); ok {
+		if ht, ok := t.(helper); ok {
 			ht.Helper()
 		}
 		verificationFailure(t, "is assignable to", expectedType, actualType)
@@ -557,52 +686,6 @@ func Assignability(t T, gotten, expected any) bool {
 	return true
 }
 
-// Panics checks if the given functions panics.
-func Panics(t T, gotten func()) bool {
-	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
-			ht.Helper()
-		}
-		verificationFailure(t, "panics", "expected function", nil)
-		return false
-	}
-
-	defer func() {
-		if r := recover(); r == nil {
-			if ht, ok := t.(testing.TB); ok {
-				ht.Helper()
-			}
-			verificationFailure(t, "panics", "expected function", "actual function")
-		}
-	}()
-
-	gotten()
-	return true
-}
-
-// NotPanics checks if the given functions does not panic.
-func NotPanics(t T, gotten func()) bool {
-	if gotten == nil {
-		if ht, ok := t.(testing.TB); ok {
-			ht.Helper()
-		}
-		verificationFailure(t, "not panics", "expected function", nil)
-		return false
-	}
-
-	defer func() {
-		if r := recover(); r != nil {
-			if ht, ok := t.(testing.TB); ok {
-				ht.Helper()
-			}
-			verificationFailure(t, "not panics", "expected function", "actual function")
-		}
-	}()
-
-	gotten()
-	return true
-}
-
 // -----------------------------------------------------------------------------
 // Helper
 // -----------------------------------------------------------------------------
@@ -635,6 +718,9 @@ func flexlen(in any) int {
 	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
 		return rv.Len()
 	}
+	if isSeq(rv) {
+		return seqlen(rv)
+	}
 	// Good old -1 is enough here, verification is above
 	return -1
 }
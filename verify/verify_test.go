@@ -9,8 +9,10 @@ package verify_test
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"tideland.dev/go/asserts/verify"
 )
@@ -263,6 +265,51 @@ func TestRange(t *testing.T) {
 	verify.FailureCount(ct, 6)
 }
 
+// TestOrderedComparisons tests the comparisons of strings and of
+// types with comparators.
+func TestOrderedComparisons(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	small, huge := big.NewInt(42), new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+
+	// Positive test cases with regular testing.T
+	verify.Less(t, "alpha", "beta")
+	verify.More(t, "beta", "alpha")
+	verify.InRange(t, "m", "a", "z")
+	verify.OutOfRange(t, "m", "n", "z")
+	verify.That(t, "alpha", verify.LessThan("beta"))
+	verify.LessFunc(t, now, later, time.Time.Compare)
+	verify.MoreFunc(t, huge, small, (*big.Int).Cmp)
+	verify.InRangeFunc(t, now.Add(time.Minute), later, now, time.Time.Compare)
+	verify.OutOfRangeFunc(t, big.NewInt(7), small, huge, (*big.Int).Cmp)
+
+	// Create continuation testing instance for negative test cases
+	ct := verify.ContinuedTesting(t)
+
+	// Negative test cases with continuation testing
+	verify.Less(ct, "beta", "alpha")
+	verify.More(ct, "alpha", "alpha")
+	verify.InRange(ct, "m", "n", "z")
+	verify.OutOfRange(ct, "m", "a", "z")
+	verify.That(ct, "beta", verify.MoreThan("gamma"))
+	verify.LessFunc(ct, now, now, time.Time.Compare)
+	verify.MoreFunc(ct, small, huge, (*big.Int).Cmp)
+	verify.InRangeFunc(ct, big.NewInt(7), small, huge, (*big.Int).Cmp)
+	verify.OutOfRangeFunc(ct, now, now, later, time.Time.Compare)
+
+	verify.FailedVerifications(ct,
+		"is less", "is more", "is in range", "is out of range", "that",
+		"is less", "is more", "is in range", "is out of range")
+
+	// Reporting of values with comparators.
+	rec := verify.NewRecorder()
+	verify.InRangeFunc(rec, big.NewInt(7), huge, small, (*big.Int).Cmp)
+
+	verify.DeepEqual(t, rec.Errors(), []string{
+		"fail \"is in range\" verification of 'big.NewInt(7)': got '7', expected ''42' to '1000000000000000000000000000000''",
+	})
+}
+
 // Define custom error types for AsError testing
 type customError struct {
 	msg string
@@ -414,4 +461,256 @@ func TestIsContinue(t *testing.T) {
 	verify.FailureCount(ct, 0)
 }
 
+// TestFailures tests the structured recording of failures.
+func TestFailures(t *testing.T) {
+	ct := verify.ContinuedTesting(t)
+	users := []string{"alice"}
+
+	verify.Equal(ct, len(users), 2, "user count")
+	verify.Length(ct, users, 3)
+	ct.Errorf("direct %s", "error")
+
+	failures := verify.Failures(ct)
+	verify.Length(t, failures, 3)
+
+	verify.Equal(t, failures[0].Verification, "is equal")
+	verify.Equal(t, failures[0].Expression, "len(users)")
+	verify.DeepEqual(t, failures[0].Got, 1)
+	verify.DeepEqual(t, failures[0].Expected, 2)
+	verify.DeepEqual(t, failures[0].Infos, []string{"user count"})
+	verify.Match(t, failures[0].File, `verify_test\.go$`)
+	verify.More(t, failures[0].Line, 0)
+	verify.Equal(t, failures[0].Message, `fail "is equal" verification of 'len(users)': got '1', expected '2' (user count)`)
+
+	verify.Equal(t, failures[1].Verification, "has length")
+	verify.Equal(t, failures[1].Line, failures[0].Line+1)
+
+	verify.Equal(t, failures[2].Verification, "")
+	verify.Equal(t, failures[2].Message, "direct error")
+
+	verify.Length(t, verify.Failures(t), 0)
+}
+
+// TestFailedVerifications tests the validation of the failed verifications.
+func TestFailedVerifications(t *testing.T) {
+	ct := verify.ContinuedTesting(t)
+
+	verify.Equal(ct, 1, 2)
+	verify.Length(ct, "abc", 2)
+
+	verify.FailedVerifications(ct, "is equal", "has length")
+
+	// Validate wrong expectations with an own continued testing.
+	cct := verify.ContinuedTesting(t)
+
+	verify.True(cct, false)
+
+	if verify.FailedVerifications(&wrappedT{cct}, "is true") {
+		t.Error("FailedVerifications should reject a non-continued testing")
+	}
+
+	verify.FailureCount(cct, 2)
+}
+
+// TestFailureExpectations tests the expectations of individual failures.
+func TestFailureExpectations(t *testing.T) {
+	ct := verify.ContinuedTesting(t)
+	users := []string{"alice"}
+
+	verify.NoFurtherFailures(ct)
+
+	verify.Equal(ct, len(users), 2)
+	verify.FailureMatches(ct, `of 'len\(users\)': got '1', expected '2'$`)
+
+	verify.True(ct, len(users) == 1)
+	verify.NoFurtherFailures(ct)
+
+	verify.Length(ct, users, 3)
+	verify.FailureMatches(ct, `"has length"`)
+	verify.NoFurtherFailures(ct)
+
+	verify.FailedVerifications(ct, "is equal", "has length")
+}
+
+// TestFailuresIn tests the validation of failures inside of a block.
+func TestFailuresIn(t *testing.T) {
+	verify.FailuresIn(t, func(ct verify.T) {
+		verify.Equal(ct, 1, 2)
+		verify.True(ct, true)
+		verify.Length(ct, "abc", 2)
+	}, "is equal", "has length")
+
+	verify.FailuresIn(t, func(ct verify.T) {
+		verify.True(ct, true)
+	})
+
+	// Fatal failures only stop the block.
+	reached := false
+	verify.FailuresIn(t, func(ct verify.T) {
+		verify.Equal(verify.Require(ct), 1, 2)
+		reached = true
+	}, "is equal")
+	verify.False(t, reached)
+	verify.FailuresIn(t, func(ct verify.T) {
+		verify.True(ct, false)
+		ct.(testing.TB).Fatalf("stopped")
+	}, "is true", "")
+
+	// Blocks inside of continued testing.
+	ct := verify.ContinuedTesting(t)
+
+	verify.FailuresIn(ct, func(ct verify.T) {
+		verify.False(ct, true)
+	}, "is false")
+	verify.Different(ct, 1, 1)
+
+	if verify.FailuresIn(&wrappedT{ct}, func(ct verify.T) {}) {
+		t.Error("FailuresIn should reject a non-testing T")
+	}
+
+	verify.FailureCount(ct, 2)
+}
+
+// TestFailureSummary tests the summary table logged when the test ends.
+func TestFailureSummary(t *testing.T) {
+	lt := &loggingTB{TB: t}
+	ct := verify.ContinuedTesting(lt)
+	users := []string{"alice"}
+
+	verify.Equal(ct, len(users), 2)
+	verify.Length(ct, users, 3)
+	verify.Substring(ct, "bob", "alice and carol")
+	ct.Errorf("direct %s", "error")
+
+	failures := verify.Failures(ct)
+	verify.Length(t, failures, 4)
+
+	lt.logs = nil
+	lt.cleanup()
+	verify.Length(t, lt.logs, 1)
+	verify.Equal(t, lt.logs[0], fmt.Sprintf(
+		"continued testing recorded 4 failure(s):\n"+
+			"#  location            verification  expression  got  expected\n"+
+			"1  verify_test.go:%d  is equal      len(users)  1    2\n"+
+			"2  verify_test.go:%d  has length    users       1    3\n"+
+			"3  verify_test.go:%d  substring     -           bob  alice and carol\n"+
+			"4  -                   direct error",
+		failures[0].Line, failures[1].Line, failures[2].Line))
+
+	// No summary without failures.
+	lt = &loggingTB{TB: t}
+	verify.ContinuedTesting(lt)
+	lt.cleanup()
+	verify.Length(t, lt.logs, 0)
+}
+
+// TestBenchmarkFailure tests the fatal semantics with a testing.B.
+func TestBenchmarkFailure(t *testing.T) {
+	reached := false
+	testing.Benchmark(func(b *testing.B) {
+		verify.True(b, true)
+		verify.True(b, false)
+		reached = true
+	})
+	verify.False(t, reached)
+
+	// Continued testing wrapping a testing.B.
+	var failures []verify.Failure
+	testing.Benchmark(func(b *testing.B) {
+		ct := verify.ContinuedTesting(b)
+		verify.Equal(ct, 1, 2)
+		verify.Equal(ct, "a", "b")
+		failures = verify.Failures(ct)
+	})
+	verify.Length(t, failures, 2)
+}
+
+// TestRequireAssert tests the selection of the failure mode per call.
+func TestRequireAssert(t *testing.T) {
+	// Positive test cases with regular testing.T
+	verify.True(verify.Require(t), true)
+	verify.True(verify.Assert(t), true)
+
+	// Soft mode continues with a testing.B, fatal mode stops
+	// a continued testing.
+	reached := 0
+	testing.Benchmark(func(b *testing.B) {
+		verify.True(verify.Assert(b), false)
+		reached++
+		ct := verify.ContinuedTesting(b)
+		verify.True(ct, false)
+		reached++
+		verify.True(verify.Require(ct), false)
+		reached++
+	})
+	verify.Equal(t, reached, 2)
+
+	// Custom T implementations opt into either mode.
+	rec := verify.NewRecorder()
+	completed := rec.Run(func(rt verify.T) {
+		verify.Equal(rt, 1, 2)
+		verify.Equal(verify.Assert(rt), 3, 4)
+		verify.Equal(verify.Require(verify.Assert(rt)), 5, 6)
+		verify.Equal(rt, 7, 8)
+	})
+	verify.False(t, completed)
+	verify.DeepEqual(t, rec.Records(), []verify.Record{
+		{Method: "Errorf", Message: `fail "is equal" verification: got '1', expected '2'`},
+		{Method: "Errorf", Message: `fail "is equal" verification: got '3', expected '4'`},
+		{Method: "Errorf", Message: `fail "is equal" verification: got '5', expected '6'`},
+		{Method: "FailNow"},
+	})
+
+	// Wrapped continued testing still records.
+	ct := verify.ContinuedTesting(t)
+	verify.Equal(verify.Assert(ct), 1, 2)
+	verify.True(t, verify.IsContinued(verify.Assert(ct)))
+	verify.FailureCount(verify.Assert(ct), 1)
+}
+
+// FuzzVerify tests the verifications with a testing.F.
+func FuzzVerify(f *testing.F) {
+	verify.True(f, true)
+
+	ct := verify.ContinuedTesting(f)
+	verify.Length(ct, "abc", 2)
+	verify.FailedVerifications(ct, "has length")
+
+	f.Add("hello")
+	f.Add("world")
+	f.Fuzz(func(t *testing.T, s string) {
+		verify.Length(t, []rune(s), utf8.RuneCountInString(s))
+	})
+}
+
+// loggingTB captures the logs and cleanups of a testing.TB.
+type loggingTB struct {
+	testing.TB
+	logs     []string
+	cleanups []func()
+}
+
+// Log implements testing.TB.
+func (lt *loggingTB) Log(args ...any) {
+	lt.logs = append(lt.logs, fmt.Sprint(args...))
+}
+
+// Cleanup implements testing.TB.
+func (lt *loggingTB) Cleanup(f func()) {
+	lt.cleanups = append(lt.cleanups, f)
+}
+
+// cleanup runs the registered cleanups in reverse order.
+func (lt *loggingTB) cleanup() {
+	for i := len(lt.cleanups) - 1; i >= 0; i-- {
+		lt.cleanups[i]()
+	}
+	lt.cleanups = nil
+}
+
+// wrappedT hides the concrete T type.
+type wrappedT struct {
+	verify.T
+}
+
 // EOF